@@ -0,0 +1,48 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package power64asm
+
+// ReconstructConst returns the constant that the first two instructions
+// of insts leave in a register, if they are one of the pairs compilers use
+// to materialize a 32-bit constant: li or lis of the register followed by
+// ori or addi of the register to itself, like
+//
+//	lis r3,0x1234
+//	ori r3,r3,0x5678
+//
+// which loads 0x12345678. The value is as the machine computes it: li and
+// lis sign-extend their immediate to 64 bits, ori ors in its immediate
+// unchanged, and addi adds its immediate sign-extended, so that lis r3,0x1235
+// followed by addi r3,r3,-0x4000 loads 0x1234c000.
+// It returns ok == false if the instructions are not such a pair.
+func ReconstructConst(insts []Inst) (value int64, ok bool) {
+	if len(insts) < 2 {
+		return 0, false
+	}
+	first, second := insts[0], insts[1]
+	hi, ok1 := first.Args[1].(Imm)
+	lo, ok2 := second.Args[2].(Imm)
+	reg := first.Args[0]
+	if !ok1 || !ok2 || second.Args[0] != reg || second.Args[1] != reg {
+		return 0, false
+	}
+	switch first.Op {
+	case LI:
+		value = int64(int16(hi))
+	case LIS:
+		value = int64(int16(hi)) << 16
+	default:
+		return 0, false
+	}
+	switch second.Op {
+	case ORI:
+		value |= int64(uint16(lo))
+	case ADDI:
+		value += int64(int16(lo))
+	default:
+		return 0, false
+	}
+	return value, true
+}
@@ -7,23 +7,27 @@ package power64asm
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
 	"log"
+	"sync"
 )
 
 const debugDecode = false
 
 // instFormat is a decoding rule for one specific instruction form.
-// a uint32 instruction ins matches the rule if ins&Mask == Value
+// An instruction ins matches the rule if ins&Mask == Value, where ins holds
+// the first instruction word in its upper 32 bits and, for prefixed
+// instructions, the suffix word in its lower 32 bits.
 // DontCare bits should be zero, but the machine might not reject
 // ones in those bits, they are mainly reserved for future expansion
 // of the instruction set.
 // The Args are stored in the same order as the instruction manual.
 type instFormat struct {
 	Op       Op
-	Mask     uint32
-	Value    uint32
-	DontCare uint32
-	Args     [5]*argField
+	Mask     uint64
+	Value    uint64
+	DontCare uint64
+	Args     [6]*argField
 }
 
 // argField indicate how to decode an argument to an instruction.
@@ -35,8 +39,8 @@ type argField struct {
 	BitFields
 }
 
-// Parse parses the Arg out from the given binary instruction i.
-func (a argField) Parse(i uint32) Arg {
+// Parse parses the Arg out from the given binary instruction words i.
+func (a argField) Parse(i [2]uint32) Arg {
 	switch a.Type {
 	default:
 		return nil
@@ -52,8 +56,12 @@ func (a argField) Parse(i uint32) Arg {
 		return F0 + Reg(a.BitFields.Parse(i))
 	case TypeVecReg:
 		return V0 + Reg(a.BitFields.Parse(i))
+	case TypeVecSReg:
+		return VS0 + Reg(a.BitFields.Parse(i)<<a.Shift) // a pair, like the XTp of lxvp, has Shift 1
 	case TypeSpReg:
 		return SpReg(a.BitFields.Parse(i))
+	case TypeACCReg:
+		return ACC0 + ACCReg(a.BitFields.Parse(i))
 	case TypeImmSigned:
 		return Imm(a.BitFields.ParseSigned(i) << a.Shift)
 	case TypeImmUnsigned:
@@ -64,25 +72,107 @@ func (a argField) Parse(i uint32) Arg {
 		return Label(a.BitFields.ParseSigned(i) << a.Shift)
 	case TypeOffset:
 		return Offset(a.BitFields.ParseSigned(i) << a.Shift)
+	case TypeOffsetUnsigned:
+		return Offset(a.BitFields.Parse(i) << a.Shift)
 	}
 }
 
+// An ArgField describes how an instruction argument is encoded.
+type ArgField struct {
+	Type  ArgType // the kind of argument, such as TypeReg or TypeImmSigned
+	Bits  int     // the width of the encoded field, in bits
+	Shift uint8   // the argument is the encoded field shifted left by Shift bits
+}
+
+// Signed reports whether the encoded field is sign-extended, like the SI
+// of addi, the D of ld and branch displacements.
+func (f ArgField) Signed() bool {
+	switch f.Type {
+	case TypeImmSigned, TypePCRel, TypeLabel, TypeOffset:
+		return true
+	}
+	return false
+}
+
+// ArgFields returns the encoding of each argument of i, which must have
+// been returned by Decode: fields[j] describes i.Args[j]. For example,
+// the offset of ld r3,8(r1) has Type TypeOffset, Bits 14 and Shift 2.
+// ArgFields returns ok == false if Enc and SuffixEnc do not encode i.Op.
+func (i Inst) ArgFields() (fields [len(Args{})]ArgField, ok bool) {
+	iform := i.format()
+	if iform == nil {
+		return fields, false
+	}
+	for j, a := range iform.Args {
+		if a == nil {
+			break
+		}
+		fields[j] = ArgField{Type: a.Type, Bits: int(a.BitFields.bits()), Shift: a.Shift}
+	}
+	return fields, true
+}
+
+// ExtendedOp returns the bits of the instruction word that select i.Op
+// among the instructions with its primary opcode, in place: the fixed bits
+// of the form Decode matched, other than the primary opcode. For example,
+// add r3,r4,r5 has xo 0x214, which is its XO field 266 at bits 22-30 with
+// the OE bit 21 and Rc bit 31 clear, and add. has 0x215. For a prefixed
+// instruction the bits are those of the suffix, SuffixEnc.
+// ExtendedOp returns ok == false if i is not an instruction returned by
+// Decode or is identified by its primary opcode alone, like addi.
+func (i Inst) ExtendedOp() (xo uint32, ok bool) {
+	iform := i.format()
+	if iform == nil {
+		return 0, false
+	}
+	mask, word := uint32(iform.Mask>>32), i.Enc
+	if i.Len == 8 {
+		mask, word = uint32(iform.Mask), i.SuffixEnc
+	}
+	mask &^= 0x3f << 26
+	return word & mask, mask != 0
+}
+
+// format returns the form of i.Op that Enc and SuffixEnc match,
+// or nil if they do not encode i.Op.
+func (i Inst) format() *instFormat {
+	ui := uint64(i.Enc)<<32 | uint64(i.SuffixEnc)
+	index := decoderIndex
+	if i.Op.flags()&flagSPE != 0 {
+		index = speDecoderIndex()
+	}
+	for _, n := range decoderCandidates(index, i.Enc) {
+		iform := &instFormats[n]
+		if ui&iform.Mask != iform.Value {
+			continue
+		}
+		if iform.Op != i.Op {
+			break
+		}
+		return iform
+	}
+	return nil
+}
+
 type ArgType int8
 
 const (
-	TypeUnknown      ArgType = iota
-	TypePCRel                // PC-relative address
-	TypeLabel                // absolute address
-	TypeReg                  // integer register
-	TypeCondRegBit           // conditional register bit (0-31)
-	TypeCondRegField         // conditional register field (0-7)
-	TypeFPReg                // floating point register
-	TypeVecReg               // vector register
-	TypeSpReg                // special register (depends on Op)
-	TypeImmSigned            // signed immediate
-	TypeImmUnsigned          // unsigned immediate/flag/mask, this is the catch-all type
-	TypeOffset               // signed offset in load/store
-	TypeLast                 // must be the last one
+	TypeUnknown        ArgType = iota
+	TypePCRel                  // PC-relative address
+	TypeLabel                  // absolute address
+	TypeReg                    // integer register
+	TypeCondRegBit             // conditional register bit (0-31)
+	TypeCondRegField           // conditional register field (0-7)
+	TypeFPReg                  // floating point register
+	TypeVecReg                 // vector register
+	TypeVecSReg                // VSX register
+	TypeSpReg                  // special register (depends on Op)
+	TypeImmSigned              // signed immediate
+	TypeImmUnsigned            // unsigned immediate/flag/mask, this is the catch-all type
+	TypeOffset                 // signed offset in load/store
+	TypeOffsetUnsigned         // unsigned offset in load/store, like the UI of the SPE loads
+	TypeACCReg                 // MMA accumulator
+	TypeLast                   // must be the last one
 )
 
 func (t ArgType) String() string {
@@ -101,6 +191,8 @@ func (t ArgType) String() string {
 		return "FPReg"
 	case TypeVecReg:
 		return "VecReg"
+	case TypeVecSReg:
+		return "VecSReg"
 	case TypeSpReg:
 		return "SpReg"
 	case TypeImmSigned:
@@ -113,6 +205,10 @@ func (t ArgType) String() string {
 		return "Label"
 	case TypeOffset:
 		return "Offset"
+	case TypeOffsetUnsigned:
+		return "OffsetUnsigned"
+	case TypeACCReg:
+		return "ACCReg"
 	}
 }
 
@@ -124,27 +220,199 @@ func (t ArgType) GoString() string {
 	return s
 }
 
-var (
-	// Errors
-	errShort   = fmt.Errorf("truncated instruction")
-	errUnknown = fmt.Errorf("unknown instruction")
+// A DecodeError is returned by Decode for bytes it cannot decode.
+type DecodeError struct {
+	Pos    int    // offset in src of the word that could not be decoded
+	Enc    uint32 // the word at Pos, with any bytes missing from src read as zero
+	Reason string // what is wrong with the word, such as "unknown instruction"
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s 0x%08x (primary opcode %#04x) at offset %d", e.Reason, e.Enc, e.Enc>>26, e.Pos)
+}
+
+// Reasons for a DecodeError.
+const (
+	reasonShort   = "truncated instruction"
+	reasonUnknown = "unknown instruction"
+	reasonTable   = "bad decoding table: offset not followed by register"
+	reasonRegPair = "odd register in register pair"
 )
 
+// decodeError returns a DecodeError for the word at offset pos in src.
+func decodeError(src []byte, pos int, ord binary.ByteOrder, reason string) error {
+	var word [4]byte
+	copy(word[:], src[pos:])
+	return &DecodeError{Pos: pos, Enc: ord.Uint32(word[:]), Reason: reason}
+}
+
+// prefixOpcode is the primary opcode of the prefix word of
+// a Power ISA 3.1 prefixed (8-byte) instruction.
+const prefixOpcode = 1
+
 var decoderCover []bool
 
+// A decoderBucket lists, in table order, the indexes in instFormats of
+// the instructions with one primary opcode. Large buckets are further
+// split by bits 21-30 of the (prefix) word, where most forms keep their
+// extended opcode.
+type decoderBucket struct {
+	formats []uint16
+	byXO    *[1 << 10][]uint16
+}
+
+// bigBucket is the size above which a decoderBucket is split by extended opcode.
+const bigBucket = 32
+
+// xoMask is the mask of bits 21-30 of the first word in the 64-bit
+// encoding that Decode matches against instFormats.
+const xoMask = 0x3ff << 33
+
+// decoderIndex maps a primary opcode to the instructions that may have it,
+// for Decode.
+var decoderIndex = newDecoderIndex(instFormats[:], false)
+
+var (
+	speDecoderOnce sync.Once
+	speDecoder     *[64]decoderBucket
+)
+
+// speDecoderIndex returns the decoderIndex of DecodeSPE, building it on first use.
+func speDecoderIndex() *[64]decoderBucket {
+	speDecoderOnce.Do(func() {
+		speDecoder = newDecoderIndex(instFormats[:], true)
+	})
+	return speDecoder
+}
+
+// inSet reports whether iform is decoded by DecodeSPE, if spe is set,
+// or by Decode otherwise. SPE replaces the Vector facility, whose primary
+// opcode 4 it shares, so each decodes only one of them.
+func (iform *instFormat) inSet(spe bool) bool {
+	if iform.Op.flags()&flagSPE != 0 {
+		return spe
+	}
+	return !spe || iform.Mask>>58 != 0x3f || iform.Value>>58 != 4
+}
+
+func newDecoderIndex(formats []instFormat, spe bool) *[64]decoderBucket {
+	var index [64]decoderBucket
+	for i := range formats {
+		iform := &formats[i]
+		if !iform.inSet(spe) {
+			continue
+		}
+		// a format that doesn't fix the primary opcode goes in every bucket
+		for op := uint64(0); op < 64; op++ {
+			if op<<58&iform.Mask == iform.Value&(0x3f<<58) {
+				index[op].formats = append(index[op].formats, uint16(i))
+			}
+		}
+	}
+	for op := range index {
+		b := &index[op]
+		if len(b.formats) <= bigBucket {
+			continue
+		}
+		b.byXO = new([1 << 10][]uint16)
+		for xo := range b.byXO {
+			for _, i := range b.formats {
+				iform := &formats[i]
+				if uint64(xo)<<33&iform.Mask&xoMask == iform.Value&xoMask {
+					b.byXO[xo] = append(b.byXO[xo], i)
+				}
+			}
+		}
+	}
+	return &index
+}
+
+// decoderCandidates returns the indexes in instFormats of the forms in index
+// that may match an instruction whose first word is w, in decoding order.
+func decoderCandidates(index *[64]decoderBucket, w uint32) []uint16 {
+	bucket := &index[w>>26]
+	if bucket.byXO != nil {
+		return bucket.byXO[w>>1&0x3ff]
+	}
+	return bucket.formats
+}
+
 // Decode decodes the leading bytes in src as a single instruction using
-// byte order ord.
+// byte order ord. The number of bytes consumed is recorded in inst.Len.
+// Decode returns a *DecodeError if src is too short to hold an instruction or
+// if the leading word does not match any known instruction.
+// With an error, inst holds as much as was decoded: Enc and SuffixEnc are
+// the words read from src, Len is the length of the instruction, or 0 if
+// src is too short to hold it, and Op and Args are set if the words match
+// an instruction whose operands are invalid, like an odd register pair.
+//
+// Instructions are made of 4-byte words, and ord only selects how each word
+// is read from src: binary.BigEndian for ppc64 and binary.LittleEndian for
+// ppc64le. The prefix word of a prefixed instruction comes first in either
+// byte order. The decoded instruction does not depend on ord.
 func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
+	return decode(src, ord, decoderIndex)
+}
+
+// DecodeSPE is like Decode, but decodes the instructions of the e500 and
+// e200 embedded cores, which implement the Signal Processing Engine (SPE)
+// and embedded floating-point instructions, like evldd and efsadd, instead
+// of the Vector facility. The encodings of the two overlap, evaddw is
+// vaddubs for example, so Decode decodes no SPE instructions and DecodeSPE
+// no Vector instructions.
+func DecodeSPE(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
+	return decode(src, ord, speDecoderIndex())
+}
+
+// DecodeReader is like Decode, but reads the instruction from r, reading
+// exactly the bytes it needs: one word or, for a prefixed instruction, two.
+// It returns io.EOF if r is at EOF before the instruction, and
+// io.ErrUnexpectedEOF if r ends in the middle of one. After a *DecodeError
+// the bytes of the instruction have been read, so the next call decodes
+// the instruction that follows it.
+func DecodeReader(r io.Reader, ord binary.ByteOrder) (inst Inst, err error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:4]); err != nil {
+		return inst, err
+	}
+	n := 4
+	if ord.Uint32(buf[:4])>>26 == prefixOpcode {
+		if _, err := io.ReadFull(r, buf[4:]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return inst, err
+		}
+		n = 8
+	}
+	return Decode(buf[:n], ord)
+}
+
+// decode implements Decode and DecodeSPE, searching the forms in index.
+func decode(src []byte, ord binary.ByteOrder, index *[64]decoderBucket) (inst Inst, err error) {
 	if len(src) < 4 {
-		return inst, errShort
+		return inst, decodeError(src, 0, ord, reasonShort)
 	}
 	if decoderCover == nil {
 		decoderCover = make([]bool, len(instFormats))
 	}
-	inst.Len = 4 // only 4-byte instructions are supported
-	ui := ord.Uint32(src[:inst.Len])
-	inst.Enc = ui
-	for i, iform := range instFormats {
+	var words [2]uint32
+	words[0] = ord.Uint32(src[:4])
+	inst.Enc = words[0]
+	if words[0]>>26 == prefixOpcode {
+		// a prefixed instruction, the suffix word follows the prefix.
+		if len(src) < 8 {
+			return inst, decodeError(src, 4, ord, reasonShort)
+		}
+		words[1] = ord.Uint32(src[4:8])
+		inst.Len = 8
+	} else {
+		inst.Len = 4
+	}
+	inst.SuffixEnc = words[1]
+	ui := uint64(words[0])<<32 | uint64(words[1])
+	for _, i := range decoderCandidates(index, words[0]) {
+		iform := &instFormats[i]
 		if ui&iform.Mask != iform.Value {
 			continue
 		}
@@ -158,9 +426,10 @@ func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
 			if argfield == nil {
 				break
 			}
-			inst.Args[i] = argfield.Parse(ui)
+			inst.Args[i] = argfield.Parse(words)
 		}
 		inst.Op = iform.Op
+		decoderCover[i] = true
 		if debugDecode {
 			log.Printf("%#x: search entry %d", ui, i)
 			continue
@@ -168,7 +437,55 @@ func Decode(src []byte, ord binary.ByteOrder) (inst Inst, err error) {
 		break
 	}
 	if inst.Op == 0 {
-		return inst, errUnknown
+		return inst, decodeError(src, 0, ord, reasonUnknown)
+	}
+	for i, arg := range inst.Args {
+		if r, ok := arg.(Reg); ok && inst.Op.isRegPairArg(i) && r.Number()%2 != 0 {
+			return inst, decodeError(src, 0, ord, reasonRegPair)
+		}
+	}
+	for i, arg := range inst.Args {
+		if _, ok := arg.(Offset); !ok {
+			continue
+		}
+		if i+1 == len(inst.Args) {
+			return inst, decodeError(src, 0, ord, reasonTable)
+		}
+		if _, ok := inst.Args[i+1].(Reg); !ok {
+			return inst, decodeError(src, 0, ord, reasonTable)
+		}
 	}
 	return inst, nil
 }
+
+// ForEachInst decodes the instructions in src, which is loaded at address pc,
+// and calls f with each instruction and its address, using byte order ord.
+// A word that does not decode is passed to f as an Inst with Op 0 and Len 4,
+// which Plan9Syntax prints as "?", and decoding resumes with the next word.
+// A truncated instruction at the end of src is handled the same way:
+// a prefix without its suffix is passed as a word that does not decode,
+// and the last bytes, if fewer than a word, as an Inst with Len covering them.
+func ForEachInst(src []byte, pc uint64, ord binary.ByteOrder, f func(pc uint64, inst Inst)) {
+	for len(src) > 0 {
+		inst := decodeNext(src, ord)
+		f(pc, inst)
+		src = src[inst.Len:]
+		pc += uint64(inst.Len)
+	}
+}
+
+// decodeNext decodes the leading instruction in the non-empty src like
+// Decode, but returns a word that does not decode as an Inst with Op 0,
+// as described for ForEachInst.
+func decodeNext(src []byte, ord binary.ByteOrder) Inst {
+	inst, err := Decode(src, ord)
+	if err != nil {
+		inst = Inst{Len: 4}
+		if len(src) < 4 {
+			inst.Len = len(src)
+		} else {
+			inst.Enc = ord.Uint32(src)
+		}
+	}
+	return inst
+}
@@ -5,9 +5,16 @@
 package power64asm
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"io"
 	"io/ioutil"
+	"math/rand"
+	"sort"
 	"strings"
 	"testing"
 )
@@ -43,15 +50,29 @@ func TestDecode(t *testing.T) {
 		}
 		syntax, asm := f[1], f[2]
 		inst, err := Decode(code, binary.BigEndian)
+		if le := swapWords(code); len(le) == len(code) {
+			inst1, err1 := Decode(le, binary.LittleEndian)
+			if inst1 != inst || (err1 == nil) != (err == nil) {
+				t.Errorf("Decode(%s) little-endian = %v, %v want %v, %v", f[0], inst1, err1, inst, err)
+			}
+		}
 		var out string
 		if err != nil {
-			out = "error: " + err.Error()
+			out = "error: " + err.(*DecodeError).Reason
 		} else {
 			switch syntax {
 			case "gnu":
-				out = GNUSyntax(inst)
-			//case "plan9":
-			//	out = plan9Syntax(inst, 0, nil, nil)
+				out = GNUSyntax(inst, 0)
+			case "plan9":
+				out = Plan9Syntax(inst, 0, nil)
+			case "plan9isa":
+				out = Plan9SyntaxMode(inst, 0, nil, ModeISAOrder)
+			case "plan9hex":
+				out = Plan9SyntaxMode(inst, 0, nil, ModeGAlias|ModeHexImm)
+			case "plan9toc":
+				out = Plan9SyntaxMode(inst, 0, nil, ModeGAlias|ModeTOC)
+			case "raw":
+				out = inst.String()
 			default:
 				t.Errorf("unknown syntax %q", syntax)
 				continue
@@ -62,3 +83,1701 @@ func TestDecode(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		src  []byte
+		ord  binary.ByteOrder
+		err  DecodeError
+		msg  string
+		inst Inst // what Decode fills in with the error
+	}{
+		{[]byte{0x7c, 0x00}, binary.BigEndian, DecodeError{0, 0x7c000000, "truncated instruction"},
+			"truncated instruction 0x7c000000 (primary opcode 0x001f) at offset 0",
+			Inst{}},
+		{[]byte{0x04, 0x00, 0x00, 0x00, 0xe4}, binary.BigEndian, DecodeError{4, 0xe4000000, "truncated instruction"},
+			"truncated instruction 0xe4000000 (primary opcode 0x0039) at offset 4",
+			Inst{Enc: 0x04000000}},
+		// all ones is fnmadd. f31,f31,f31,f31, but primary opcode 0 is unused
+		{[]byte{0x03, 0xff, 0xff, 0xff}, binary.BigEndian, DecodeError{0, 0x03ffffff, "unknown instruction"},
+			"unknown instruction 0x03ffffff (primary opcode 0x0000) at offset 0",
+			Inst{Enc: 0x03ffffff, Len: 4}},
+		{[]byte{0x00, 0x00, 0x00, 0x00}, binary.LittleEndian, DecodeError{0, 0, "unknown instruction"},
+			"unknown instruction 0x00000000 (primary opcode 0x0000) at offset 0",
+			Inst{Len: 4}},
+		{[]byte{0x10, 0x00, 0x65, 0xe0}, binary.LittleEndian, DecodeError{0, 0xe0650010, "odd register in register pair"},
+			"odd register in register pair 0xe0650010 (primary opcode 0x0038) at offset 0",
+			Inst{Op: LQ, Enc: 0xe0650010, Len: 4, Args: Args{R3, Offset(16), R5}}},
+	}
+	for _, tt := range tests {
+		inst, err := Decode(tt.src, tt.ord)
+		e, ok := err.(*DecodeError)
+		if !ok {
+			t.Errorf("Decode(% x) error = %v, want a *DecodeError", tt.src, err)
+			continue
+		}
+		if *e != tt.err {
+			t.Errorf("Decode(% x) error = %+v, want %+v", tt.src, *e, tt.err)
+		}
+		if msg := e.Error(); msg != tt.msg {
+			t.Errorf("Decode(% x) error = %q, want %q", tt.src, msg, tt.msg)
+		}
+		if inst != tt.inst {
+			t.Errorf("Decode(% x) inst = %#v, want %#v", tt.src, inst, tt.inst)
+		}
+	}
+}
+
+func TestArgFields(t *testing.T) {
+	tests := []struct {
+		enc    uint32
+		arg    int
+		field  ArgField
+		signed bool
+	}{
+		{0x3864ff9c, 2, ArgField{TypeImmSigned, 16, 0}, true},   // addi r3,r4,-100: SI
+		{0x3864ff9c, 0, ArgField{TypeReg, 5, 0}, false},         // addi r3,r4,-100: RT
+		{0xe8610008, 1, ArgField{TypeOffset, 14, 2}, true},      // ld r3,8(r1): DS
+		{0x5483183e, 2, ArgField{TypeImmUnsigned, 5, 0}, false}, // rotlwi r3,r4,3: SH
+		{0x78830fc2, 2, ArgField{TypeImmUnsigned, 6, 0}, false}, // rldicl r3,r4,33,31: sh5 || sh0:4
+		{0x41820010, 2, ArgField{TypePCRel, 14, 2}, true},       // beq 0x10: BD
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		fields, ok := inst.ArgFields()
+		if !ok {
+			t.Errorf("%v: ArgFields() not ok", inst)
+			continue
+		}
+		if f := fields[tt.arg]; f != tt.field || f.Signed() != tt.signed {
+			t.Errorf("%v: ArgFields()[%d] = %+v, Signed() = %v, want %+v, %v", inst, tt.arg, f, f.Signed(), tt.field, tt.signed)
+		}
+		if f := fields[inst.NumArgs()-1]; f.Bits == 0 {
+			t.Errorf("%v: ArgFields()[%d] is empty", inst, inst.NumArgs()-1)
+		}
+	}
+	if _, ok := (Inst{Op: ADD, Enc: 0x38640010}).ArgFields(); ok {
+		t.Errorf("ArgFields of an Inst whose Enc is not its Op is ok")
+	}
+}
+
+func TestInstStringZero(t *testing.T) {
+	var inst Inst
+	if s := inst.String(); s != "?" {
+		t.Errorf("Inst{}.String() = %q, want %q", s, "?")
+	}
+	if s := Plan9Syntax(inst, 0, nil); s != "?" {
+		t.Errorf("Plan9Syntax(Inst{}) = %q, want %q", s, "?")
+	}
+}
+
+func TestPlan9Sub(t *testing.T) {
+	// subf rt,ra,rb computes rt = rb - ra, and so does SUB ra, rb, rt,
+	// whose last operand is the destination and first the subtrahend.
+	tests := []struct {
+		enc  uint32
+		gnu  string
+		want string
+	}{
+		{0x7c642850, "subf r3,r4,r5", "SUB R4, R5, R3"},
+		{0x7c642851, "subf. r3,r4,r5", "SUBCC R4, R5, R3"},
+		{0x7c642c51, "subfo. r3,r4,r5", "SUBVCC R4, R5, R3"},
+		{0x7c642810, "subfc r3,r4,r5", "SUBC R4, R5, R3"},
+		{0x7c642910, "subfe r3,r4,r5", "SUBE R4, R5, R3"},
+		{0x7c6401d0, "subfme r3,r4", "SUBME R4, R3"},
+		{0x7c640190, "subfze r3,r4", "SUBZE R4, R3"},
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if s := GNUSyntax(inst, 0); s != tt.gnu {
+			t.Errorf("GNUSyntax(%#x) = %q, want %q", tt.enc, s, tt.gnu)
+		}
+		if s := Plan9Syntax(inst, 0, nil); s != tt.want {
+			t.Errorf("Plan9Syntax(%#x) = %q, want %q", tt.enc, s, tt.want)
+		}
+		if inst.Args[1] != R4 || inst.Args[0] != R3 {
+			t.Errorf("%#x: Args = %v, want RT R3 and RA R4", tt.enc, inst.Args)
+		}
+	}
+}
+
+func TestPlan9UnknownOp(t *testing.T) {
+	for _, op := range []Op{Op(len(opstr)), 9999} {
+		inst := Inst{Op: op, Args: Args{R3, Imm(1)}}
+		want := fmt.Sprintf("? (op=%d)", int(op))
+		for _, mode := range []Mode{0, ModeGAlias, ModeISAOrder, ModeISANames} {
+			if s := Plan9SyntaxMode(inst, 0, nil, mode); s != want {
+				t.Errorf("Plan9SyntaxMode(Op(%d), %#x) = %q, want %q", int(op), mode, s, want)
+			}
+		}
+		var buf bytes.Buffer
+		if err := inst.WritePlan9(&buf, 0, nil); err != nil || buf.String() != want {
+			t.Errorf("WritePlan9(Op(%d)) = %q, %v want %q", int(op), buf.String(), err, want)
+		}
+	}
+}
+
+func TestInstGoString(t *testing.T) {
+	// Each text is the %#v of the decoded enc, and inst is text pasted
+	// as Go, so the literal compiling and comparing equal checks the
+	// round trip.
+	tests := []struct {
+		enc  string
+		text string
+		inst Inst
+	}{
+		{"38640010",
+			`Inst{Op: ADDI, Enc: 0x38640010, Len: 4, Args: Args{R3, R4, Imm(16)}}`,
+			Inst{Op: ADDI, Enc: 0x38640010, Len: 4, Args: Args{R3, R4, Imm(16)}}},
+		{"e8610008",
+			`Inst{Op: LD, Enc: 0xe8610008, Len: 4, Args: Args{R3, Offset(8), R1}}`,
+			Inst{Op: LD, Enc: 0xe8610008, Len: 4, Args: Args{R3, Offset(8), R1}}},
+		{"41820010",
+			`Inst{Op: BC, Enc: 0x41820010, Len: 4, Args: Args{Imm(12), Cond0EQ, PCRel(16)}}`,
+			Inst{Op: BC, Enc: 0x41820010, Len: 4, Args: Args{Imm(12), Cond0EQ, PCRel(16)}}},
+		{"7c6802a6",
+			`Inst{Op: MFSPR, Enc: 0x7c6802a6, Len: 4, Args: Args{R3, SpReg(8)}}`,
+			Inst{Op: MFSPR, Enc: 0x7c6802a6, Len: 4, Args: Args{R3, SpReg(8)}}},
+		{"7ca0212d",
+			`Inst{Op: STWCX_, Enc: 0x7ca0212d, Len: 4, Args: Args{R5, R0, R4}}`,
+			Inst{Op: STWCX_, Enc: 0x7ca0212d, Len: 4, Args: Args{R5, R0, R4}}},
+		{"7f832000",
+			`Inst{Op: CMPW, Enc: 0x7f832000, Len: 4, Args: Args{CR7, R3, R4}}`,
+			Inst{Op: CMPW, Enc: 0x7f832000, Len: 4, Args: Args{CR7, R3, R4}}},
+		{"0610000038600010",
+			`Inst{Op: PADDI, Enc: 0x06100000, SuffixEnc: 0x38600010, Len: 8, Args: Args{R3, R0, Imm(16), Imm(1)}}`,
+			Inst{Op: PADDI, Enc: 0x06100000, SuffixEnc: 0x38600010, Len: 8, Args: Args{R3, R0, Imm(16), Imm(1)}}},
+		{"",
+			`Inst{}`,
+			Inst{}},
+	}
+	for _, tt := range tests {
+		var inst Inst
+		if tt.enc != "" {
+			enc, _ := hex.DecodeString(tt.enc)
+			var err error
+			inst, err = Decode(enc, binary.BigEndian)
+			if err != nil {
+				t.Errorf("Decode(%s): %v", tt.enc, err)
+				continue
+			}
+		}
+		if inst != tt.inst {
+			t.Errorf("Decode(%s) = %#v, want %#v", tt.enc, inst, tt.inst)
+		}
+		s := fmt.Sprintf("%#v", inst)
+		if s != tt.text {
+			t.Errorf("Decode(%s): %%#v = %s, want %s", tt.enc, s, tt.text)
+		}
+		if _, err := parser.ParseExpr(s); err != nil {
+			t.Errorf("Decode(%s): %s does not parse: %v", tt.enc, s, err)
+		}
+	}
+}
+
+// TestMalformedOffset checks that the formatters print an Offset that is
+// not followed by its base register as ???, rather than panicking.
+func TestMalformedOffset(t *testing.T) {
+	tests := []struct {
+		inst       Inst
+		gnu, plan9 string
+	}{
+		{Inst{Op: LD, Len: 4, Args: Args{R3, Offset(8)}}, "ld r3,???(+8)", "MOVD ???(+8), R3"},
+		{Inst{Op: LD, Len: 4, Args: Args{R3, Offset(8), Imm(1)}}, "ld r3,???(+8),1", "MOVD ???(+8), $1, R3"},
+		{Inst{Op: LD, Len: 4, Args: Args{R3, R4, R5, R6, Offset(-8)}}, "ld r3,r4,r5,r6,???(-8)", "MOVD R4, R5, R6, ???(-8), R3"},
+	}
+	for _, tt := range tests {
+		if s := GNUSyntax(tt.inst, 0); s != tt.gnu {
+			t.Errorf("GNUSyntax(%#v) = %q, want %q", tt.inst, s, tt.gnu)
+		}
+		if s := Plan9Syntax(tt.inst, 0, nil); s != tt.plan9 {
+			t.Errorf("Plan9Syntax(%#v) = %q, want %q", tt.inst, s, tt.plan9)
+		}
+	}
+	inst := Inst{Op: LD, Args: Args{R3, Offset(8), R1}}
+	for _, i := range []int{-1, len(inst.Args), len(inst.Args) + 1} {
+		removeArg(&inst, i)
+	}
+	if want := (Args{R3, Offset(8), R1}); inst.Args != want {
+		t.Errorf("removeArg out of range changed Args to %v, want %v", inst.Args, want)
+	}
+}
+
+func TestActiveArgs(t *testing.T) {
+	tests := []struct {
+		enc   uint32
+		nargs int
+	}{
+		{0x4c00012c, 0}, // isync
+		{0x78832980, 4}, // rldicl r3,r4,5,6
+		{0x54831928, 5}, // rlwinm r3,r4,3,4,20
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		args := inst.ActiveArgs()
+		if n := inst.NumArgs(); n != tt.nargs || len(args) != tt.nargs {
+			t.Errorf("%v: NumArgs() = %d, len(ActiveArgs()) = %d, want %d", inst, n, len(args), tt.nargs)
+		}
+		for i, a := range args {
+			if a == nil {
+				t.Errorf("%v: ActiveArgs()[%d] is nil", inst, i)
+			}
+		}
+	}
+}
+
+// TestPlan9ISAOrderPairs checks that instructions that move data in
+// opposite directions, like ld and std, print differently in every mode,
+// and in particular that ModeISAOrder does not print them as Go syntax.
+func TestPlan9ISAOrderPairs(t *testing.T) {
+	pairs := [][2]uint32{
+		{0xe8640008, 0xf8640008}, // ld r3,8(r4); std r3,8(r4)
+		{0x80640008, 0x90640008}, // lwz r3,8(r4); stw r3,8(r4)
+		{0x7c64282a, 0x7c64292a}, // ldx r3,r4,r5; stdx r3,r4,r5
+		{0xc8240008, 0xd8240008}, // lfd f1,8(r4); stfd f1,8(r4)
+		{0x7c6802a6, 0x7c6803a6}, // mflr r3; mtlr r3
+	}
+	for _, pair := range pairs {
+		var insts [2]Inst
+		for i, enc := range pair {
+			var code [4]byte
+			binary.BigEndian.PutUint32(code[:], enc)
+			inst, err := Decode(code[:], binary.BigEndian)
+			if err != nil {
+				t.Fatalf("Decode(%#x): %v", enc, err)
+			}
+			insts[i] = inst
+		}
+		for mode := Mode(0); mode < ModeISANames<<1; mode++ {
+			s0 := Plan9SyntaxMode(insts[0], 0, nil, mode)
+			s1 := Plan9SyntaxMode(insts[1], 0, nil, mode)
+			if s0 == s1 {
+				t.Errorf("mode %#x: %v and %v both print as %s", mode, insts[0], insts[1], s0)
+			}
+		}
+	}
+	for enc, want := range map[uint32]string{
+		0xe8640008: "LD R3, 8(R4)",
+		0xf8640008: "STD R3, 8(R4)",
+		0x7c8307b4: "EXTSW R3, R4",
+		0x7c6803a6: "MTSPR LR, R3",
+	} {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], enc)
+		inst, _ := Decode(code[:], binary.BigEndian)
+		if s := Plan9SyntaxMode(inst, 0, nil, ModeISAOrder); s != want {
+			t.Errorf("Plan9SyntaxMode(%v, ModeISAOrder) = %s, want %s", inst, s, want)
+		}
+	}
+}
+
+// swapWords returns a copy of code with the bytes of each 4-byte word reversed,
+// converting between big- and little-endian instruction streams.
+// A trailing partial word is dropped.
+func swapWords(code []byte) []byte {
+	out := make([]byte, len(code)/4*4)
+	for i := 0; i+4 <= len(code); i += 4 {
+		binary.LittleEndian.PutUint32(out[i:], binary.BigEndian.Uint32(code[i:]))
+	}
+	return out
+}
+
+// TestDecodeSPE checks DecodeSPE, and that Decode, which decodes the
+// Vector facility instead, decodes no SPE instructions.
+func TestDecodeSPE(t *testing.T) {
+	tests := []struct {
+		enc   uint32
+		gnu   string
+		plan9 string
+		vec   string // the syntax of Decode, if the word is a vector instruction
+	}{
+		{0x10642ac0, "efsadd r3,r4,r5", "EFSADD R4, R5, R3", ""},
+		{0x108002cf, "efscfd r4,r0", "EFSCFD R0, R4", ""},
+		{0x10640b01, "evldd r3,8(r4)", "EVLDD 8(R4), R3", ""},
+		{0x1064fb01, "evldd r3,248(r4)", "EVLDD 248(R4), R3", ""},
+		{0x10600301, "evldd r3,0(0)", "EVLDD 0(0), R3", ""},
+		{0x10641311, "evlwhe r3,8(r4)", "EVLWHE 8(R4), R3", "mullhwu. r3,r4,r2"},
+		{0x10641309, "evlhhesplat r3,4(r4)", "EVLHHESPLAT 4(R4), R3", ""},
+		{0x10640b21, "evstdd r3,8(r4)", "EVSTDD R3, 8(R4)", "vmhraddshs v3,v4,v1,v12"},
+		{0x10642b20, "evstddx r3,r4,r5", "EVSTDDX R3, (R4)(R5)", "vmhaddshs v3,v4,v5,v12"},
+		{0x10642a00, "evaddw r3,r4,r5", "EVADDW R4, R5, R3", "vaddubs v3,v4,v5"},
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := DecodeSPE(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("DecodeSPE(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if s := GNUSyntax(inst, 0); s != tt.gnu {
+			t.Errorf("DecodeSPE(%#08x) [gnu] = %s want %s", tt.enc, s, tt.gnu)
+		}
+		if s := Plan9Syntax(inst, 0, nil); s != tt.plan9 {
+			t.Errorf("DecodeSPE(%#08x) [plan9] = %s want %s", tt.enc, s, tt.plan9)
+		}
+		if src, err := inst.Encode(binary.BigEndian); err != nil || !bytes.Equal(src, code[:]) {
+			t.Errorf("%v: Encode = % x, %v want % x", inst, src, err, code)
+		}
+		vec := "error: unknown instruction"
+		if inst, err := Decode(code[:], binary.BigEndian); err == nil {
+			vec = GNUSyntax(inst, 0)
+		}
+		if tt.vec == "" {
+			tt.vec = "error: unknown instruction"
+		}
+		if vec != tt.vec {
+			t.Errorf("Decode(%#08x) = %s want %s", tt.enc, vec, tt.vec)
+		}
+	}
+}
+
+func TestDecodeReader(t *testing.T) {
+	code := []byte{
+		0x7c, 0x64, 0x2a, 0x14, // add r3,r4,r5
+		0x04, 0x10, 0x00, 0x00, 0xe4, 0x60, 0x00, 0x10, // pld r3,16(0),1
+		0x00, 0x00, 0x00, 0x00, // not an instruction
+		0x38, 0x63, 0x00, 0x10, // addi r3,r3,16
+	}
+	r := bytes.NewReader(code)
+	for i, want := range []Op{ADD, PLD, 0, ADDI} {
+		inst, err := DecodeReader(r, binary.BigEndian)
+		if inst.Op != want || (err != nil) != (want == 0) {
+			t.Errorf("DecodeReader #%d = %v, %v want %v", i, inst, err, want)
+		}
+	}
+	if inst, err := DecodeReader(r, binary.BigEndian); err != io.EOF {
+		t.Errorf("DecodeReader at EOF = %v, %v want io.EOF", inst, err)
+	}
+
+	// EOF in the middle of a word or between a prefix and its suffix
+	for _, src := range [][]byte{code[:2], code[4:8], code[4:10]} {
+		if inst, err := DecodeReader(bytes.NewReader(src), binary.BigEndian); err != io.ErrUnexpectedEOF {
+			t.Errorf("DecodeReader(% x) = %v, %v want io.ErrUnexpectedEOF", src, inst, err)
+		}
+	}
+}
+
+func TestDecodeLittleEndian(t *testing.T) {
+	// function prologue and epilogue from a ppc64le binary
+	code := []byte{
+		0xa6, 0x02, 0x08, 0x7c, // mfspr r0,8
+		0x10, 0x00, 0x01, 0xf8, // std r0,16(r1)
+		0xe1, 0xff, 0x21, 0xf8, // stdu r1,-32(r1)
+		0x00, 0x00, 0x00, 0x04, 0x08, 0x00, 0x62, 0xe4, // pld r3,8(r2)
+		0x20, 0x00, 0x80, 0x4e, // blr
+	}
+	want := []string{
+		"mflr r0",
+		"std r0,16(r1)",
+		"stdu r1,-32(r1)",
+		"pld r3,8(r2)",
+		"blr",
+	}
+	for _, w := range want {
+		inst, err := Decode(code, binary.LittleEndian)
+		if err != nil {
+			t.Fatalf("Decode(% x): %v", code, err)
+		}
+		if s := GNUSyntax(inst, 0); s != w {
+			t.Errorf("Decode(% x) = %s want %s", code[:inst.Len], s, w)
+		}
+		code = code[inst.Len:]
+	}
+}
+
+func TestForEachInst(t *testing.T) {
+	code := []byte{
+		0x7c, 0x08, 0x02, 0xa6, // mfspr r0,8
+		0xf8, 0x01, 0x00, 0x10, // std r0,16(r1)
+		0xf8, 0x21, 0xff, 0xe1, // stdu r1,-32(r1)
+		0x00, 0x00, 0x00, 0x00, // not an instruction
+		0x04, 0x00, 0x00, 0x00, 0xe4, 0x62, 0x00, 0x08, // pld r3,8(r2)
+		0x4e, 0x80, 0x00, 0x20, // blr
+		0x04, 0x00, 0x00, 0x00, 0xe4, 0x62, // truncated prefixed instruction
+	}
+	want := []string{
+		"0x1000 MOVD LR, R0",
+		"0x1004 MOVD R0, 16(R1)",
+		"0x1008 MOVDU R1, -32(R1)",
+		"0x100c ?",
+		"0x1010 PLD 8(R2), R3",
+		"0x1018 RET",
+		"0x101c ?",
+		"0x1020 ?",
+	}
+	var out []string
+	ForEachInst(code, 0x1000, binary.BigEndian, func(pc uint64, inst Inst) {
+		out = append(out, fmt.Sprintf("%#x %s", pc, Plan9Syntax(inst, pc, nil)))
+	})
+	if strings.Join(out, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ForEachInst:\n%s\nwant:\n%s", strings.Join(out, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRegNumber(t *testing.T) {
+	tests := []struct {
+		r    Reg
+		name string
+		num  int
+	}{
+		{R0, "R0", 0},
+		{R31, "R31", 31},
+		{F3, "F3", 3},
+		{V31, "V31", 31},
+		{VS63, "VS63", 63},
+		{0, "Reg(0)", -1},
+	}
+	for _, tt := range tests {
+		if s, n := tt.r.String(), tt.r.Number(); s != tt.name || n != tt.num {
+			t.Errorf("Reg(%d): String() = %s, Number() = %d, want %s, %d", int(tt.r), s, n, tt.name, tt.num)
+		}
+	}
+}
+
+func TestCondReg(t *testing.T) {
+	// every CR bit, in order
+	for f := 0; f < 8; f++ {
+		for b, bit := range []string{"LT", "GT", "EQ", "SO"} {
+			c := Cond0LT + CondReg(4*f+b)
+			name := fmt.Sprintf("Cond%d%s", f, bit)
+			if s, cf, cb := c.String(), c.Field(), c.Bit(); s != name || cf != f || cb != b {
+				t.Errorf("CondReg(%d): String() = %s, Field() = %d, Bit() = %d, want %s, %d, %d", int(c), s, cf, cb, name, f, b)
+			}
+		}
+	}
+	tests := []struct {
+		c          CondReg
+		name       string
+		field, bit int
+	}{
+		{Cond0LT, "Cond0LT", 0, 0},
+		{Cond1GT, "Cond1GT", 1, 1},
+		{Cond7SO, "Cond7SO", 7, 3},
+		{CR0, "CR0", 0, -1},
+		{CR2, "CR2", 2, -1},
+		{CR7, "CR7", 7, -1},
+		{0, "CondReg(0)", -1, -1},
+		{CR7 + 1, "CondReg(41)", -1, -1},
+	}
+	for _, tt := range tests {
+		if s, f, b := tt.c.String(), tt.c.Field(), tt.c.Bit(); s != tt.name || f != tt.field || b != tt.bit {
+			t.Errorf("CondReg(%d): String() = %s, Field() = %d, Bit() = %d, want %s, %d, %d", int(tt.c), s, f, b, tt.name, tt.field, tt.bit)
+		}
+	}
+}
+
+func TestCRUses(t *testing.T) {
+	tests := []struct {
+		enc           uint32
+		reads, writes string
+	}{
+		{0x7f832000, "[]", "[CR7]"},                             // cmpw cr7,r3,r4
+		{0x7c032000, "[]", "[CR0]"},                             // cmpw r3,r4
+		{0x7ca32180, "[]", "[CR1]"},                             // cmprb cr1,1,r3,r4
+		{0x7c642a15, "[]", "[CR0]"},                             // add. r3,r4,r5
+		{0x7c642a14, "[]", "[]"},                                // add r3,r4,r5
+		{0xfc22182b, "[]", "[CR1]"},                             // fadd. f1,f2,f3
+		{0x10611406, "[]", "[CR6]"},                             // vcmpequb. v3,v1,v2
+		{0x7c60212d, "[]", "[CR0]"},                             // stwcx. r3,0,r4
+		{0x41860010, "[Cond1EQ]", "[]"},                         // beq cr1,.+16
+		{0x4d820020, "[Cond0EQ]", "[]"},                         // beqlr
+		{0x42000010, "[]", "[]"},                                // bdnz .+16
+		{0x4c813202, "[Cond0GT Cond1EQ]", "[Cond1LT]"},          // crand 4*cr1+lt,gt,4*cr1+eq
+		{0x7c642f9e, "[Cond7EQ]", "[]"},                         // isel r3,r4,r5,4*cr7+eq
+		{0x7c681120, "[]", "[CR0 CR7]"},                         // mtcrf 0x81,r3
+		{0x7c600026, "[CR0 CR1 CR2 CR3 CR4 CR5 CR6 CR7]", "[]"}, // mfcr r3
+		{0x7c720026, "[CR2]", "[]"},                             // mfocrf r3,0x20
+		{0xfc880080, "[]", "[CR1]"},                             // mcrfs cr1,2: the FPSCR field is not a CR field
+		{0xfd00310c, "[]", "[]"},                                // mtfsfi 2,3
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		reads, writes := inst.CRUses()
+		if r, w := fmt.Sprint(reads), fmt.Sprint(writes); r != tt.reads || w != tt.writes {
+			t.Errorf("%v: CRUses() = %s, %s want %s, %s", inst, r, w, tt.reads, tt.writes)
+		}
+	}
+}
+
+func TestArgRoles(t *testing.T) {
+	tests := []struct {
+		enc   uint32
+		roles string
+	}{
+		{0x7c642a14, "[write read read]"},                // add r3,r4,r5
+		{0x7c83283a, "[write read read]"},                // and r3,r4,r5
+		{0x84640008, "[write read read-write]"},          // lwzu r3,8(r4)
+		{0x7c64286e, "[write read-write read]"},          // lwzux r3,r4,r5
+		{0x90640008, "[read read read]"},                 // stw r3,8(r4)
+		{0x9421ffe0, "[read read read-write]"},           // stwu r1,-32(r1)
+		{0x7c60212d, "[read read read]"},                 // stwcx. r3,0,r4
+		{0x5083103a, "[read-write read read read read]"}, // rlwimi r3,r4,2,0,29
+		{0xf0221908, "[read-write read read]"},           // xsmaddadp vs1,vs2,vs3
+		{0x102c138d, "[read-write read read]"},           // vinsertw v1,v2,12
+		{0xed0218dc, "[write read read]"},                // xvf32ger a2,vs34,vs3
+		{0xed0218d4, "[read-write read read]"},           // xvf32gerpp a2,vs34,vs3
+		{0x7f832000, "[write read read]"},                // cmpw cr7,r3,r4
+		{0x7c0803a6, "[write read]"},                     // mtlr r0
+		{0x41860010, "[read read read]"},                 // beq cr1,.+16
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if roles := fmt.Sprint(inst.ArgRoles()); roles != tt.roles {
+			t.Errorf("%v: ArgRoles() = %s, want %s", inst, roles, tt.roles)
+		}
+	}
+}
+
+func TestInstEnc(t *testing.T) {
+	tests := []struct {
+		code      []byte
+		enc, sufx uint32
+		primary   uint8
+	}{
+		{[]byte{0x7c, 0x64, 0x2a, 0x14}, 0x7c642a14, 0, 31},                                 // add r3,r4,r5
+		{[]byte{0xe8, 0x64, 0x00, 0x08}, 0xe8640008, 0, 58},                                 // ld r3,8(r4)
+		{[]byte{0x4e, 0x80, 0x00, 0x20}, 0x4e800020, 0, 19},                                 // blr
+		{[]byte{0xfc, 0x22, 0x20, 0xfa}, 0xfc2220fa, 0, 63},                                 // fmadd f1,f2,f3,f4
+		{[]byte{0x04, 0x00, 0x00, 0x00, 0xe4, 0x62, 0x00, 0x08}, 0x04000000, 0xe4620008, 1}, // pld r3,8(r2)
+	}
+	for _, tt := range tests {
+		for _, ord := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			code := tt.code
+			if ord == binary.LittleEndian {
+				code = swapWords(code)
+			}
+			inst, err := Decode(code, ord)
+			if err != nil {
+				t.Errorf("Decode(% x): %v", code, err)
+				continue
+			}
+			if inst.Enc != tt.enc || inst.SuffixEnc != tt.sufx || inst.PrimaryOp() != tt.primary {
+				t.Errorf("Decode(% x) %v: Enc = %#x, SuffixEnc = %#x, PrimaryOp() = %d, want %#x, %#x, %d",
+					code, ord, inst.Enc, inst.SuffixEnc, inst.PrimaryOp(), tt.enc, tt.sufx, tt.primary)
+			}
+		}
+	}
+}
+
+func TestExtendedOp(t *testing.T) {
+	tests := []struct {
+		enc uint64
+		xo  uint32
+		ok  bool
+	}{
+		{0x7c642a14, 0x214, true},        // add r3,r4,r5: XO 266
+		{0x7c642a15, 0x215, true},        // add. r3,r4,r5
+		{0x7c642e14, 0x614, true},        // addo r3,r4,r5
+		{0xe8640008, 0, true},            // ld r3,8(r4): DS-form XO 0
+		{0xe8640009, 1, true},            // ldu r3,8(r4)
+		{0x4e800020, 0x20, true},         // blr: bclr XO 16, LK 0
+		{0xfc2220fa, 0x3a, true},         // fmadd f1,f2,f3,f4: A-form XO 29
+		{0x38640010, 0, false},           // addi r3,r4,16
+		{0x0610000038600001, 0, false},   // paddi r3,0,1,1
+		{0x07900000ed0218dc, 0xd8, true}, // pmxvf32ger a2,vs34,vs3,0,0: suffix XO 27
+	}
+	for _, tt := range tests {
+		var code [8]byte
+		binary.BigEndian.PutUint64(code[:], tt.enc)
+		src := code[:]
+		if tt.enc < 1<<32 {
+			src = code[4:]
+		}
+		inst, err := Decode(src, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if xo, ok := inst.ExtendedOp(); xo != tt.xo || ok != tt.ok {
+			t.Errorf("%v: ExtendedOp() = %#x, %v, want %#x, %v", inst, xo, ok, tt.xo, tt.ok)
+		}
+	}
+	if _, ok := (Inst{Op: ADD, Enc: 0x38640010, Len: 4}).ExtendedOp(); ok {
+		t.Errorf("ExtendedOp of add with the encoding of addi succeeded")
+	}
+}
+
+// TestEncodeRoundTrip checks that encoding a decoded instruction
+// reproduces its bytes, except for the bits outside the opcode and
+// argument fields, like don't care bits, which Encode clears.
+// The corpus is testdata/decode.txt and random instances of every form.
+func TestEncodeRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var words []uint64
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(line, "|", 2)
+		if len(f) < 2 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		code, err := hex.DecodeString(f[0])
+		if err != nil || len(code) != 4 && len(code) != 8 {
+			continue
+		}
+		if len(code) == 4 {
+			code = append(code, 0, 0, 0, 0)
+		}
+		words = append(words, binary.BigEndian.Uint64(code))
+	}
+	r := rand.New(rand.NewSource(1))
+	for _, iform := range instFormats {
+		for j := 0; j < 8; j++ {
+			words = append(words, iform.Value|r.Uint64()&^iform.Mask)
+		}
+	}
+	var code [8]byte
+	for _, ui := range words {
+		binary.BigEndian.PutUint64(code[:], ui)
+		inst, err := Decode(code[:], binary.BigEndian)
+		spe := false
+		if err != nil {
+			inst, err = DecodeSPE(code[:], binary.BigEndian)
+			spe = true
+		}
+		if err != nil {
+			continue
+		}
+		var want uint64
+		for i := range instFormats {
+			iform := &instFormats[i]
+			if ui&iform.Mask == iform.Value && iform.inSet(spe) {
+				used := [2]uint32{uint32(iform.Mask >> 32), uint32(iform.Mask)}
+				for _, a := range iform.Args {
+					if a != nil {
+						a.BitFields.place(&used, 1<<a.BitFields.bits()-1)
+					}
+				}
+				want = ui & (uint64(used[0])<<32 | uint64(used[1]))
+				break
+			}
+		}
+		if inst.Len == 4 {
+			want &^= 1<<32 - 1
+		}
+		src, err := inst.Encode(binary.BigEndian)
+		if err != nil {
+			t.Errorf("%v: Encode: %v", inst, err)
+			continue
+		}
+		binary.BigEndian.PutUint64(code[:], want)
+		if !bytes.Equal(src, code[:inst.Len]) {
+			t.Errorf("%v: Encode = % x, want % x", inst, src, code[:inst.Len])
+		}
+		if le, err := inst.Encode(binary.LittleEndian); err != nil || !bytes.Equal(le, swapWords(src)) {
+			t.Errorf("%v: Encode(LittleEndian) = % x, %v, want % x", inst, le, err, swapWords(src))
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		enc  uint64 // 0 if Encode must fail
+	}{
+		{Inst{Op: B, Args: Args{PCRel(0x100)}}, 0x48000100},
+		{Inst{Op: BL, Args: Args{PCRel(-4)}}, 0x4bfffffd},
+		{Inst{Op: BA, Args: Args{Label(0xffffff00)}}, 0x4bffff02},
+		{Inst{Op: BC, Args: Args{Imm(12), Cond0EQ, PCRel(0x20)}}, 0x41820020},
+		{Inst{Op: ADDI, Args: Args{R3, R4, Imm(-100)}}, 0x3864ff9c},
+		{Inst{Op: ADDI, Args: Args{R3, R0, Imm(5)}}, 0x38600005}, // decodes as li
+		{Inst{Op: LD, Args: Args{R3, Offset(8), R1}}, 0xe8610008},
+		{Inst{Op: STW, Args: Args{R18, Offset(-4), R1}}, 0x9241fffc},
+		{Inst{Op: ADD, Args: Args{R3, R4, R5}}, 0x7c642a14},
+		{Inst{Op: MFSPR, Args: Args{R0, SpReg(8)}}, 0x7c0802a6},
+		{Inst{Op: PLD, Args: Args{R3, Offset(8), R2, Imm(0)}}, 0x04000000e4620008},
+		{Inst{Op: HASHST, Args: Args{R3, Offset(-8), R1}}, 0x7fe11da5},
+		{Inst{Op: HASHCHK, Args: Args{R3, Offset(-512), R1}}, 0x7c011de4},
+		{Inst{Op: LXVP, Args: Args{VS34, Offset(32), R3}}, 0x18630020},
+		{Inst{Op: XVF32GER, Args: Args{ACC2, VS34, VS3}}, 0xed0218dc},
+		{Inst{Op: XXMFACC, Args: Args{ACC1}}, 0x7c800162},
+		{Inst{Op: B, Args: Args{PCRel(2)}}, 0},                    // odd displacement
+		{Inst{Op: B, Args: Args{PCRel(1 << 25)}}, 0},              // too far
+		{Inst{Op: ADDI, Args: Args{R3, R4, Imm(1 << 15)}}, 0},     // does not fit SI
+		{Inst{Op: ADDI, Args: Args{R3, F4, Imm(1)}}, 0},           // not an integer register
+		{Inst{Op: ADD, Args: Args{R3, R4}}, 0},                    // missing RB
+		{Inst{Op: ADD, Args: Args{R3, R4, R5, R6}}, 0},            // extra argument
+		{Inst{Op: LQ, Args: Args{R3, Offset(0), R1}}, 0},          // odd register pair
+		{Inst{Op: LXVP, Args: Args{VS35, Offset(32), R3}}, 0},     // odd VSX register pair
+		{Inst{Op: HASHST, Args: Args{R3, Offset(8), R1}}, 0},      // the offset must be negative
+		{Inst{Op: 0, Args: Args{}}, 0},                            // unknown instruction
+		{Inst{Op: Op(len(opstr) + 1), Args: Args{R3, R4, R5}}, 0}, // unknown instruction
+	}
+	for _, tt := range tests {
+		src, err := tt.inst.Encode(binary.BigEndian)
+		if tt.enc == 0 {
+			if err == nil {
+				t.Errorf("%v: Encode = % x, want error", tt.inst, src)
+			}
+			continue
+		}
+		var want [8]byte
+		binary.BigEndian.PutUint64(want[:], tt.enc<<32)
+		n := 4
+		if tt.enc>>32 != 0 {
+			binary.BigEndian.PutUint64(want[:], tt.enc)
+			n = 8
+		}
+		if err != nil || !bytes.Equal(src, want[:n]) {
+			t.Errorf("%v: Encode = % x, %v, want % x", tt.inst, src, err, want[:n])
+		}
+	}
+}
+
+func TestPlan9Symname(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		if 0x11000 <= addr && addr < 0x12000 {
+			return "runtime.data", 0x11000
+		}
+		return "", 0
+	}
+	tests := []struct {
+		enc  uint64 // a prefixed instruction has the prefix in the high word
+		pc   uint64
+		want string
+	}{
+		{0x4c600005, 0x1000, "ADDPCIS $runtime.data+4(SB), R3"}, // addpcis r3,1
+		{0x4c600005, 0xffc, "ADDPCIS $runtime.data(SB), R3"},
+		{0x4c600005, 0x2000, "ADDPCIS $0x12004, R3"},
+		{0x4c600004, 0x1000, "ADDPCIS $0x1004, R3"},  // lnia r3
+		{0x4c7fffc5, 0x11000, "ADDPCIS $0x1004, R3"}, // addpcis r3,-1
+
+		// prefixed instructions with R=1 address memory relative to the pc
+		{0x04100001e4600008, 0x1000, "PLD runtime.data+8(SB), R3"}, // pld r3,0x10008(0),1
+		{0x04100000e4600010, 0x10ff0, "PLD runtime.data(SB), R3"},  // pld r3,16(0),1
+		{0x0413ffffe460fff0, 0x11010, "PLD runtime.data(SB), R3"},  // pld r3,-16(0),1
+		{0x04100000e4600010, 0x2000, "PLD 0x2010, R3"},
+		{0x04000001e4640008, 0x1000, "PLD 65544(R4), R3"}, // pld r3,0x10008(r4),0
+		{0x06100000906000f0, 0x11000, "PSTW R3, runtime.data+240(SB)"},
+		{0x06100000386000f0, 0x11000, "PADDI $runtime.data+240(SB), R3"}, // pla r3,240
+		{0x06000000386400f0, 0x11000, "PADDI $240, R4, R3"},
+	}
+	for _, tt := range tests {
+		code := make([]byte, 4, 8)
+		if tt.enc>>32 != 0 {
+			code = code[:8]
+			binary.BigEndian.PutUint64(code, tt.enc)
+		} else {
+			binary.BigEndian.PutUint32(code, uint32(tt.enc))
+		}
+		inst, err := Decode(code, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if s := Plan9Syntax(inst, tt.pc, symname); s != tt.want {
+			t.Errorf("Plan9Syntax(%v, %#x) = %s want %s", inst, tt.pc, s, tt.want)
+		}
+	}
+}
+
+// TestAbsoluteBranch checks that the targets of absolute branches (AA=1)
+// do not depend on the pc, unlike those of relative branches.
+func TestAbsoluteBranch(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		switch {
+		case 0x1000 <= addr && addr < 0x1100:
+			return "rom.reset", 0x1000
+		case 0x3000 <= addr && addr < 0x3100:
+			return "main.f", 0x3000
+		}
+		return "", 0
+	}
+	tests := []struct {
+		enc       uint32
+		gnu, plan string
+	}{
+		{0x48001000, "b 0x3000", "BR main.f(SB)"}, // b .+0x1000
+		{0x48001002, "ba 0x1000", "BA rom.reset(SB)"},
+		{0x48001012, "ba 0x1010", "BA rom.reset+16(SB)"},
+		{0x48001003, "bla 0x1000", "CALL rom.reset(SB)"},
+		{0x42801002, "bca 20,lt,0x1000", "BCA $20, LT, rom.reset(SB)"},
+		{0x4bffff02, "ba 0xffffffffffffff00", "BA 0xffffffffffffff00"},
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if s := GNUSyntax(inst, 0x2000); s != tt.gnu {
+			t.Errorf("GNUSyntax(%v, 0x2000) = %s want %s", inst, s, tt.gnu)
+		}
+		if s := Plan9Syntax(inst, 0x2000, symname); s != tt.plan {
+			t.Errorf("Plan9Syntax(%v, 0x2000) = %s want %s", inst, s, tt.plan)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		enc  uint64
+		want string
+	}{
+		{0xe8610008, `{"op":"ld","args":[{"type":"reg","value":"R3","num":3},{"type":"offset","value":"+8","num":8},{"type":"reg","value":"R1","num":1}],"len":4}`},
+		{0x41820020, `{"op":"bc","args":[{"type":"imm","value":"12","num":12},{"type":"cr","value":"Cond0EQ","num":2},{"type":"pcrel","value":"PC+0x20","num":32}],"len":4}`},
+		{0x7c0802a6, `{"op":"mfspr","args":[{"type":"reg","value":"R0","num":0},{"type":"spr","value":"SpReg(8)","num":8}],"len":4}`},
+		{0x04100000e4600010, `{"op":"pld","args":[{"type":"reg","value":"R3","num":3},{"type":"offset","value":"+16","num":16},{"type":"reg","value":"R0","num":0},{"type":"imm","value":"1","num":1}],"len":8}`},
+		{0x7c800162, `{"op":"xxmfacc","args":[{"type":"acc","value":"ACC1","num":1}],"len":4}`},
+		{0x4bffff02, `{"op":"ba","args":[{"type":"label","value":"0xffffff00","num":-256}],"len":4}`},
+		{0x60000000, `{"op":"ori","args":[{"type":"reg","value":"R0","num":0},{"type":"reg","value":"R0","num":0},{"type":"imm","value":"0","num":0}],"len":4}`},
+	}
+	for _, tt := range tests {
+		var code [8]byte
+		binary.BigEndian.PutUint64(code[:], tt.enc)
+		src := code[:]
+		if tt.enc < 1<<32 {
+			src = code[4:]
+		}
+		inst, err := Decode(src, binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		b, err := json.Marshal(inst)
+		if err != nil || string(b) != tt.want {
+			t.Errorf("json.Marshal(%v) = %s, %v, want %s", inst, b, err, tt.want)
+			continue
+		}
+		var v struct {
+			Op   string
+			Args []struct {
+				Type  string
+				Value string
+				Num   *int64
+			}
+			Len int
+		}
+		if err := json.Unmarshal(b, &v); err != nil || v.Op != inst.Op.String() || len(v.Args) != inst.NumArgs() || v.Len != inst.Len {
+			t.Errorf("json.Unmarshal(%s) = %+v, %v", b, v, err)
+		}
+	}
+	if b, err := json.Marshal(Inst{}); err != nil || string(b) != `{"op":"Op(0)","args":[],"len":0}` {
+		t.Errorf("json.Marshal(Inst{}) = %s, %v", b, err)
+	}
+}
+
+func TestCrossesBoundary(t *testing.T) {
+	// nops up to a pnop at 8, a nop at 16 and a pnop at 60 crossing into
+	// the next block, then a pnop at 0x48, which crosses nothing.
+	var code [0x50]byte
+	for pc := 0; pc < len(code); pc += 4 {
+		binary.BigEndian.PutUint32(code[pc:], 0x60000000)
+	}
+	for _, pc := range []int{8, 60, 0x48} {
+		binary.BigEndian.PutUint64(code[pc:], 0x0700000000000000)
+	}
+	var crossing []uint64
+	ForEachInst(code[:], 0x1000, binary.BigEndian, func(pc uint64, inst Inst) {
+		if (inst.Op == PNOP) != (inst.Len == 8) {
+			t.Errorf("%#x: %v has Len %d", pc, inst, inst.Len)
+		}
+		if inst.CrossesBoundary(pc) {
+			crossing = append(crossing, pc)
+		}
+	})
+	if len(crossing) != 1 || crossing[0] != 0x103c {
+		t.Errorf("crossing prefixed instructions at %#x, want [0x103c]", crossing)
+	}
+	nop := Inst{Op: ORI, Len: 4, Args: Args{R0, R0, Imm(0)}}
+	if nop.CrossesBoundary(60) {
+		t.Errorf("4-byte %v at 60 CrossesBoundary", nop)
+	}
+}
+
+func TestCallTarget(t *testing.T) {
+	symname := func(addr uint64) (string, uint64) {
+		switch {
+		case 0x1000 <= addr && addr < 0x1100:
+			return "rom.reset", 0x1000
+		case 0x3000 <= addr && addr < 0x3100:
+			return "main.f", 0x3000
+		}
+		return "", 0
+	}
+	tests := []struct {
+		enc       uint32
+		call      string
+		gnu, plan string
+	}{
+		{0x48001001, "main.f", "bl main.f", "CALL main.f(SB)"}, // bl .+0x1000
+		{0x48001011, "", "bl 0x3010", "BL main.f+16(SB)"},      // bl .+0x1010
+		{0x48000101, "", "bl 0x2100", "BL 0x2100"},             // bl .+0x100
+		{0x48001000, "", "b 0x3000", "BR main.f(SB)"},          // b .+0x1000
+		{0x48001003, "rom.reset", "bla rom.reset", "CALL rom.reset(SB)"},
+		{0x48001013, "", "bla 0x1010", "BLA rom.reset+16(SB)"},
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if name, ok := inst.CallTarget(0x2000, symname); name != tt.call || ok != (tt.call != "") {
+			t.Errorf("%v: CallTarget(0x2000) = %q, %v want %q", inst, name, ok, tt.call)
+		}
+		if s := GNUSyntaxSym(inst, 0x2000, symname); s != tt.gnu {
+			t.Errorf("GNUSyntaxSym(%v, 0x2000) = %s want %s", inst, s, tt.gnu)
+		}
+		if s := Plan9Syntax(inst, 0x2000, symname); s != tt.plan {
+			t.Errorf("Plan9Syntax(%v, 0x2000) = %s want %s", inst, s, tt.plan)
+		}
+	}
+}
+
+// TestSymSizeLookup resolves branch targets with a symbol table that
+// finds the last symbol starting at or before an address, like a sorted
+// ELF symbol table, and uses the symbol sizes to reject the addresses
+// past the end of a symbol.
+func TestSymSizeLookup(t *testing.T) {
+	syms := []struct {
+		name       string
+		addr, size uint64
+	}{
+		{"main.f", 0x1000, 0x40},
+		{"main.g", 0x1040, 0x20},
+		{"main.h", 0x1080, 0x10}, // 0x1060-0x1080 is padding
+	}
+	var lookup SymSizeLookup = func(addr uint64) (string, uint64, uint64) {
+		for i := len(syms) - 1; i >= 0; i-- {
+			if s := syms[i]; s.addr <= addr {
+				return s.name, s.addr, s.size
+			}
+		}
+		return "", 0, 0
+	}
+	tests := []struct {
+		enc  uint32
+		pc   uint64
+		want string
+	}{
+		{0x48000010, 0x1000, "BR main.f+16(SB)"}, // b .+0x10
+		{0x4bfffff1, 0x1030, "BL main.f+32(SB)"}, // bl .-0x10
+		{0x48000040, 0x1000, "BR main.g(SB)"},
+		{0x41820028, 0x1040, "BEQ 0x1068"}, // past main.g
+		{0x48000008, 0x1080, "BR main.h+8(SB)"},
+		{0x48000100, 0x1080, "BR 0x1180"},
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if s := Plan9Syntax(inst, tt.pc, lookup.SymLookup()); s != tt.want {
+			t.Errorf("Plan9Syntax(%v, %#x) = %s want %s", inst, tt.pc, s, tt.want)
+		}
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	var code []byte
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(line, "|", 2)
+		if len(f) < 2 || strings.HasPrefix(line, "#") || len(f[0]) != 8 && len(f[0]) != 16 {
+			continue
+		}
+		enc, err := hex.DecodeString(f[0])
+		if err != nil {
+			b.Fatal(err)
+		}
+		code = append(code, enc...)
+	}
+	b.SetBytes(int64(len(code)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for src := code; len(src) > 0; {
+			inst, err := Decode(src, binary.BigEndian)
+			if err != nil {
+				inst.Len = 4
+			}
+			src = src[inst.Len:]
+		}
+	}
+}
+
+// TestDecoderIndex checks that Decode and DecodeSPE, which only search the
+// formats in their decoder index, find the same instruction as a search of
+// all the instFormats they decode.
+func TestDecoderIndex(t *testing.T) {
+	linear := func(ui uint64, spe bool) Op {
+		for i := range instFormats {
+			iform := &instFormats[i]
+			if ui&iform.Mask == iform.Value && iform.inSet(spe) {
+				return iform.Op
+			}
+		}
+		return 0
+	}
+	r := rand.New(rand.NewSource(1))
+	var words []uint64
+	for _, iform := range instFormats {
+		for j := 0; j < 8; j++ {
+			words = append(words, iform.Value|r.Uint64()&^iform.Mask)
+		}
+	}
+	for j := 0; j < 100000; j++ {
+		words = append(words, r.Uint64())
+	}
+	var code [8]byte
+	for _, ui := range words {
+		binary.BigEndian.PutUint64(code[:], ui)
+		if ui>>58 != prefixOpcode {
+			ui &^= 1<<32 - 1 // Decode only reads the suffix of prefixed instructions
+		}
+		inst, _ := Decode(code[:], binary.BigEndian)
+		if op := linear(ui, false); inst.Op != op {
+			t.Errorf("Decode(%#016x) = %v, want %v", ui, inst.Op, op)
+		}
+		inst, _ = DecodeSPE(code[:], binary.BigEndian)
+		if op := linear(ui, true); inst.Op != op {
+			t.Errorf("DecodeSPE(%#016x) = %v, want %v", ui, inst.Op, op)
+		}
+	}
+}
+
+func TestBranchTarget(t *testing.T) {
+	tests := []struct {
+		enc  uint32
+		addr uint64
+		ok   bool
+	}{
+		{0x48000010, 0x1010, true},             // b .+0x10
+		{0x4bfffffd, 0xffc, true},              // bl .-4
+		{0x48000102, 0x100, true},              // ba 0x100
+		{0x48000103, 0x100, true},              // bla 0x100
+		{0x4bffff02, 0xffffffffffffff00, true}, // ba -0x100
+		{0x41820020, 0x1020, true},             // beq .+0x20
+		{0x4182ffe2, 0xffffffffffffffe0, true}, // bca 12,eq,-0x20
+		{0x42800009, 0x1008, true},             // bcl 20,eq,.+8
+		{0x4e800020, 0, false},                 // blr
+		{0x4e800421, 0, false},                 // bctrl
+		{0x7c642a14, 0, false},                 // add r3,r4,r5
+	}
+	for _, tt := range tests {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", tt.enc, err)
+			continue
+		}
+		if addr, ok := inst.BranchTarget(0x1000); addr != tt.addr || ok != tt.ok {
+			t.Errorf("%v: BranchTarget(0x1000) = %#x, %v want %#x, %v", inst, addr, ok, tt.addr, tt.ok)
+		}
+	}
+}
+
+// FuzzDecode checks that Decode and the syntax printers don't panic
+// or produce runaway output for arbitrary input.
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte{0x7c, 0x64, 0x2a, 0x14})                         // add r3,r4,r5
+	f.Add([]byte{0xe8, 0x64, 0x00, 0x08})                         // ld r3,8(r4)
+	f.Add([]byte{0x04, 0x00, 0x00, 0x00, 0xe4, 0x62, 0x00, 0x08}) // pld r3,8(r2)
+	f.Add([]byte{0x04, 0x00, 0x00, 0x00})                         // truncated prefixed instruction
+	f.Fuzz(func(t *testing.T, src []byte) {
+		for _, ord := range []binary.ByteOrder{binary.BigEndian, binary.LittleEndian} {
+			inst, err := Decode(src, ord)
+			if err != nil {
+				continue
+			}
+			if inst.Len != 4 && inst.Len != 8 || inst.Len > len(src) {
+				t.Fatalf("Decode(% x) = %v with Len %d", src, inst, inst.Len)
+			}
+			for _, s := range []string{
+				inst.String(),
+				GNUSyntax(inst, 0x1000),
+				Plan9Syntax(inst, 0x1000, nil),
+				Plan9SyntaxMode(inst, 0x1000, nil, ModeISAOrder),
+			} {
+				if len(s) > 200 {
+					t.Fatalf("Decode(% x) printed as %d bytes: %q", src, len(s), s)
+				}
+			}
+		}
+	})
+}
+
+// TestOpString checks that every Op has a distinct, well-formed
+// mnemonic and Go name.
+func TestOpString(t *testing.T) {
+	for op, want := range map[Op]string{
+		ADD: "add", ADD_: "add.", ADDO: "addo", ADDO_: "addo.",
+		STWCX_: "stwcx.", RLDICL: "rldicl", SETBC: "setbc", Op(0): "Op(0)",
+	} {
+		if s := op.String(); s != want {
+			t.Errorf("Op(%d).String() = %q, want %q", int(op), s, want)
+		}
+	}
+	names := make(map[string]Op)
+	goNames := make(map[string]Op)
+	for op := Op(1); int(op) < len(opstr); op++ {
+		s := op.String()
+		valid := s != "" && 'a' <= s[0] && s[0] <= 'z'
+		for i, c := range s {
+			valid = valid && ('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '_' || c == '.' && i == len(s)-1)
+		}
+		if !valid {
+			t.Errorf("Op(%d).String() = %q, not a mnemonic", int(op), s)
+		}
+		if other, ok := names[s]; ok {
+			t.Errorf("Op(%d) and Op(%d) are both %q", int(other), int(op), s)
+		}
+		names[s] = op
+		g := op.goString()
+		if other, ok := goNames[g]; ok {
+			t.Errorf("Ops %v and %v are both %s", other, op, g)
+		}
+		goNames[g] = op
+	}
+}
+
+func TestLookupOp(t *testing.T) {
+	ops := Ops()
+	if len(ops) == 0 || ops[0] != 1 {
+		t.Fatalf("Ops() starts with %v, want Op(1)", ops[:1])
+	}
+	for i, op := range ops {
+		if i > 0 && op <= ops[i-1] {
+			t.Errorf("Ops() lists %v after %v", op, ops[i-1])
+		}
+		if got, ok := LookupOp(op.String()); got != op || !ok {
+			t.Errorf("LookupOp(%q) = %v, %v want %v, true", op.String(), got, ok, op)
+		}
+	}
+	for _, s := range []string{"", "mr", "blr", "ADD", "Op(1)"} {
+		if op, ok := LookupOp(s); ok {
+			t.Errorf("LookupOp(%q) = %v, want not found", s, op)
+		}
+	}
+}
+
+func TestOpFlags(t *testing.T) {
+	tests := []struct {
+		op                                     Op
+		branch, load, store, privileged, float bool
+	}{
+		{B, true, false, false, false, false},
+		{BCLR, true, false, false, false, false},
+		{BCCTRL, true, false, false, false, false},
+		{SC, false, false, false, false, false},
+		{RFID, false, false, false, true, false},
+		{STOP, false, false, false, true, false},
+		{LWZ, false, true, false, false, false},
+		{LDARX, false, true, false, false, false},
+		{LQ, false, true, false, false, false},
+		{PLD, false, true, false, false, false},
+		{LVX, false, true, false, false, false},
+		{LVSL, false, false, false, false, false},
+		{LXVD2X, false, true, false, false, false},
+		{STW, false, false, true, false, false},
+		{STDCX_, false, false, true, false, false},
+		{PSTXV, false, false, true, false, false},
+		{HASHST, false, false, true, false, false},
+		{HASHCHKP, false, true, false, true, false},
+		{DCBZ, false, false, false, false, false},
+		{LFD, false, true, false, false, true},
+		{STFIWX, false, false, true, false, true},
+		{FADD, false, false, false, false, true},
+		{FCMPU, false, false, false, false, true},
+		{MFFS, false, false, false, false, true},
+		{MTFSF, false, false, false, false, true},
+		{DADD, false, false, false, false, true},
+		{DADDQ, false, false, false, false, true},
+		{XSADDDP, false, false, false, false, false},
+		{VADDFP, false, false, false, false, false},
+		{MTMSRD, false, false, false, true, false},
+		{TLBIE, false, false, false, true, false},
+		{SLBMTE, false, false, false, true, false},
+		{LDCIX, false, true, false, true, false},
+		{MTSPR, false, false, false, false, false},
+		{ADD, false, false, false, false, false},
+		{0, false, false, false, false, false},
+		{Op(65535), false, false, false, false, false},
+	}
+	for _, tt := range tests {
+		if tt.op.IsBranch() != tt.branch || tt.op.IsLoad() != tt.load || tt.op.IsStore() != tt.store ||
+			tt.op.IsPrivileged() != tt.privileged || tt.op.IsFloatingPoint() != tt.float {
+			t.Errorf("%v: IsBranch, IsLoad, IsStore, IsPrivileged, IsFloatingPoint = %v, %v, %v, %v, %v want %v, %v, %v, %v, %v",
+				tt.op, tt.op.IsBranch(), tt.op.IsLoad(), tt.op.IsStore(), tt.op.IsPrivileged(), tt.op.IsFloatingPoint(),
+				tt.branch, tt.load, tt.store, tt.privileged, tt.float)
+		}
+	}
+}
+
+// plan9Bench is a sample of common instructions for the formatting benchmarks.
+var plan9Bench = []uint32{
+	0x7c642a14, // add r3,r4,r5
+	0x38640010, // addi r3,r4,16
+	0xe8610008, // ld r3,8(r1)
+	0xf8410018, // std r2,24(r1)
+	0x7c6428ae, // lbzx r3,r4,r5
+	0x7c832378, // mr r3,r4
+	0x2c030000, // cmpwi r3,0
+	0x41820010, // beq 0x10
+	0x4e800020, // blr
+	0x78832ea4, // sldi r3,r4,5
+}
+
+func TestWritePlan9(t *testing.T) {
+	var buf bytes.Buffer
+	for _, enc := range plan9Bench {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#x): %v", enc, err)
+			continue
+		}
+		buf.Reset()
+		if err := inst.WritePlan9(&buf, 0x1000, nil); err != nil {
+			t.Errorf("%v: WritePlan9: %v", inst, err)
+		}
+		if want := Plan9Syntax(inst, 0x1000, nil); buf.String() != want {
+			t.Errorf("%v: WritePlan9 wrote %q, want %q", inst, buf.String(), want)
+		}
+	}
+}
+
+func benchInsts(b *testing.B) []Inst {
+	var insts []Inst
+	for _, enc := range plan9Bench {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			b.Fatal(err)
+		}
+		insts = append(insts, inst)
+	}
+	return insts
+}
+
+func BenchmarkPlan9Syntax(b *testing.B) {
+	insts := benchInsts(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, inst := range insts {
+			Plan9Syntax(inst, 0x1000, nil)
+		}
+	}
+}
+
+func BenchmarkWritePlan9(b *testing.B) {
+	insts := benchInsts(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, inst := range insts {
+			inst.WritePlan9(ioutil.Discard, 0x1000, nil)
+		}
+	}
+}
+
+func BenchmarkAppendPlan9(b *testing.B) {
+	insts := benchInsts(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		for _, inst := range insts {
+			buf = inst.AppendPlan9(buf[:0], 0x1000, nil)
+		}
+	}
+}
+
+// disasmCode returns the words of testdata/decode.txt as a block of big-endian
+// machine code, followed by some branches into a symbol table, which
+// benchSymname describes.
+func disasmCode(tb testing.TB) []byte {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var code []byte
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.SplitN(line, "|", 2)
+		if len(f) < 2 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if w, err := hex.DecodeString(f[0]); err == nil {
+			code = append(code, w...)
+		}
+	}
+	for j := 0; j < 64; j++ {
+		code = append(code, 0x48, 0x00, byte(j), 0x01) // bl .+j*256
+	}
+	return code
+}
+
+// benchSyms is a symbol table of 16-byte symbols from 0x1000 to 0x11000,
+// sorted by address.
+var benchSyms = func() (syms []uint64) {
+	for addr := uint64(0x1000); addr < 0x11000; addr += 16 {
+		syms = append(syms, addr)
+	}
+	return syms
+}()
+
+// benchSymname finds the symbol containing addr in benchSyms.
+func benchSymname(addr uint64) (string, uint64) {
+	i := sort.Search(len(benchSyms), func(i int) bool { return benchSyms[i] > addr }) - 1
+	if i < 0 || addr-benchSyms[i] >= 16 {
+		return "", 0
+	}
+	return "sym", benchSyms[i]
+}
+
+// TestDisassembler checks that a Disassembler prints the same text as
+// ForEachInst and Plan9Syntax, or DataWord for the words that do not decode.
+func TestDisassembler(t *testing.T) {
+	code := disasmCode(t)
+	for _, symname := range []SymLookup{nil, benchSymname} {
+		var want []string
+		ForEachInst(code, 0x1000, binary.BigEndian, func(pc uint64, inst Inst) {
+			text := Plan9Syntax(inst, pc, symname)
+			if inst.Op == 0 {
+				text = DataWord(inst.Enc)
+			}
+			want = append(want, fmt.Sprintf("%#x %s", pc, text))
+		})
+		d := NewDisassembler(code, 0x1000, binary.BigEndian, symname)
+		for i := 0; ; i++ {
+			pc, text, ok := d.Next()
+			if !ok {
+				if i != len(want) {
+					t.Errorf("Disassembler stopped after %d instructions, want %d", i, len(want))
+				}
+				break
+			}
+			if i >= len(want) {
+				t.Errorf("Disassembler: extra instruction %#x %s", pc, text)
+				break
+			}
+			if s := fmt.Sprintf("%#x %s", pc, text); s != want[i] {
+				t.Errorf("Disassembler = %s, want %s", s, want[i])
+			}
+		}
+	}
+}
+
+var instSink Inst
+
+func TestDisassemblerAppendNext(t *testing.T) {
+	code := disasmCode(t)
+	d := NewDisassembler(code, 0x1000, binary.BigEndian, benchSymname)
+	ad := NewDisassembler(code, 0x1000, binary.BigEndian, benchSymname)
+	buf := []byte("prefix ")
+	for {
+		pc, text, ok := d.Next()
+		apc, abuf, aok := ad.AppendNext(buf)
+		if apc != pc || aok != ok || string(abuf) != "prefix "+text {
+			t.Fatalf("AppendNext = %#x %q %v, want %#x %q %v", apc, abuf, aok, pc, "prefix "+text, ok)
+		}
+		if !ok {
+			break
+		}
+		buf = abuf[:len("prefix ")]
+	}
+	// the text of every instruction fits the buffer after the first pass
+	ad = NewDisassembler(code, 0x1000, binary.BigEndian, benchSymname)
+	for ok := true; ok; _, buf, ok = ad.AppendNext(buf[:0]) {
+	}
+	allocs := testing.AllocsPerRun(10, func() {
+		ad := NewDisassembler(code, 0x1000, binary.BigEndian, nil)
+		for ok := true; ok; _, buf, ok = ad.AppendNext(buf[:0]) {
+		}
+	})
+	// Decode itself allocates for some arguments, so compare with it
+	decodeAllocs := testing.AllocsPerRun(10, func() {
+		for src := code; len(src) > 0; {
+			inst := decodeNext(src, binary.BigEndian)
+			if inst.Op == 0 && len(src) < 4 {
+				inst.Len = 1
+			}
+			instSink = inst
+			src = src[inst.Len:]
+		}
+	})
+	if allocs > decodeAllocs+1 {
+		t.Errorf("AppendNext loop: %v allocs, want at most %v for decoding and the Disassembler", allocs, decodeAllocs+1)
+	}
+}
+
+func TestReconstructConst(t *testing.T) {
+	tests := []struct {
+		code  [2]uint32
+		value int64
+		ok    bool
+	}{
+		{[2]uint32{0x3c601234, 0x60635678}, 0x12345678, true},          // lis r3,0x1234; ori r3,r3,0x5678
+		{[2]uint32{0x3c601234, 0x6063ffff}, 0x1234ffff, true},          // ori does not sign-extend
+		{[2]uint32{0x3c608000, 0x60630001}, -0x7fffffff, true},         // lis sign-extends
+		{[2]uint32{0x3c601235, 0x3863c000}, 0x1234c000, true},          // lis r3,0x1235; addi r3,r3,-0x4000
+		{[2]uint32{0x3c607fff, 0x38638000}, 0x7fff0000 - 0x8000, true}, // addi does sign-extend
+		{[2]uint32{0x3c600000, 0x3863ffff}, -1, true},
+		{[2]uint32{0x3860ffff, 0x6063ffff}, -1, true}, // li r3,-1; ori r3,r3,0xffff
+		{[2]uint32{0x38600010, 0x38630010}, 32, true}, // li r3,16; addi r3,r3,16
+		{[2]uint32{0x3c601234, 0x60645678}, 0, false}, // ori r4,r3: another register
+		{[2]uint32{0x3c601234, 0x60835678}, 0, false}, // ori r3,r4: another register
+		{[2]uint32{0x3c831234, 0x38635678}, 0, false}, // addis r4,r3 is not lis
+		{[2]uint32{0x3c601234, 0x64635678}, 0, false}, // oris
+		{[2]uint32{0x60635678, 0x3c601234}, 0, false}, // the wrong order
+	}
+	for _, tt := range tests {
+		var insts []Inst
+		for _, enc := range tt.code {
+			var code [4]byte
+			binary.BigEndian.PutUint32(code[:], enc)
+			inst, err := Decode(code[:], binary.BigEndian)
+			if err != nil {
+				t.Fatalf("Decode(%#08x): %v", enc, err)
+			}
+			insts = append(insts, inst)
+		}
+		if value, ok := ReconstructConst(insts); value != tt.value || ok != tt.ok {
+			t.Errorf("ReconstructConst(%v; %v) = %#x, %v want %#x, %v", insts[0], insts[1], value, ok, tt.value, tt.ok)
+		}
+	}
+	if _, ok := ReconstructConst(nil); ok {
+		t.Errorf("ReconstructConst(nil) = _, true")
+	}
+}
+
+// TestInstLen checks that a prefixed instruction is 8 bytes long and that
+// the Disassembler and branch targets account for it.
+func TestInstLen(t *testing.T) {
+	code := []byte{
+		0x06, 0x00, 0x00, 0x00, 0x38, 0x64, 0x00, 0x10, // paddi r3,r4,16
+		0x38, 0x63, 0x00, 0x10, // addi r3,r3,16
+		0x4b, 0xff, 0xff, 0xf4, // b .-12
+	}
+	for _, tt := range []struct {
+		code []byte
+		len  int
+	}{
+		{code, 8},
+		{code[8:], 4},
+	} {
+		inst, err := Decode(tt.code, binary.BigEndian)
+		if err != nil || inst.Len != tt.len {
+			t.Errorf("Decode(% x) = %v with Len %d, %v want Len %d", tt.code[:4], inst, inst.Len, err, tt.len)
+		}
+	}
+	want := []string{
+		"0x1000 PADDI $16, R4, R3",
+		"0x1008 ADD $16, R3, R3",
+		"0x100c BR 0x1000",
+	}
+	var out []string
+	d := NewDisassembler(code, 0x1000, binary.BigEndian, nil)
+	for {
+		pc, text, ok := d.Next()
+		if !ok {
+			break
+		}
+		out = append(out, fmt.Sprintf("%#x %s", pc, text))
+	}
+	if strings.Join(out, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Disassembler:\n%s\nwant:\n%s", strings.Join(out, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestStackRegNames(t *testing.T) {
+	// R1 and R2 are the stack and TOC pointers in every ABI, so unlike R30
+	// they have no alias and print as R1 and R2 in every mode.
+	for _, tt := range []struct {
+		enc  uint32
+		regs []string
+	}{
+		{0xe861fff0, []string{"(R1)", "R3"}},  // ld r3,-16(r1)
+		{0xfbc1fff8, []string{"(R1)", "R30"}}, // std r30,-8(r1)
+		{0x3821ffe0, []string{"R1"}},          // addi r1,r1,-32
+		{0xe8620008, []string{"(R2)", "R3"}},  // ld r3,8(r2)
+		{0x7fc1f378, []string{"R1", "R30"}},   // mr r1,r30
+	} {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		for mode := Mode(0); mode < ModeTOC<<1; mode++ {
+			out := Plan9SyntaxMode(inst, 0, nil, mode)
+			for _, reg := range tt.regs {
+				if reg == "R30" && mode&ModeGAlias != 0 {
+					reg = "g"
+				}
+				if !strings.Contains(out, reg) {
+					t.Errorf("Plan9SyntaxMode(%#08x, %#x) = %q, want %s", tt.enc, mode, out, reg)
+				}
+			}
+		}
+		out := GNUSyntax(inst, 0)
+		for _, reg := range tt.regs {
+			if reg = strings.ToLower(reg); !strings.Contains(out, reg) {
+				t.Errorf("GNUSyntax(%#08x) = %q, want %s", tt.enc, out, reg)
+			}
+		}
+	}
+}
+
+func TestPlan9ISANames(t *testing.T) {
+	for _, tt := range []struct {
+		enc          uint32
+		plan9, names string
+	}{
+		{0x88830008, "MOVBZ 8(R3), R4", "LBZ 8(R3), R4"},
+		{0xf8610010, "MOVD R3, 16(R1)", "STD R3, 16(R1)"},
+		{0x48000010, "BR 0x110", "B 0x110"},
+		{0x48000011, "BL 0x110", "BL 0x110"},
+		{0x4182000c, "BEQ 0x10c", "BC $12, EQ, 0x10c"},
+		{0x4e800020, "RET", "BCLR $20, LT, $0"},
+		{0x38630010, "ADD $16, R3, R3", "ADDI $16, R3, R3"},
+		{0x7c642a15, "ADDCC R4, R5, R3", "ADD. R4, R5, R3"},
+		{0x7c832378, "MOVD R4, R3", "OR R4, R4, R3"},
+		{0x3c601234, "MOVD $305397760, R3", "LIS $4660, R3"},
+		{0x7c0803a6, "MOVD R0, LR", "MTSPR R0, LR"},
+	} {
+		var code [4]byte
+		binary.BigEndian.PutUint32(code[:], tt.enc)
+		inst, err := Decode(code[:], binary.BigEndian)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if out := Plan9SyntaxMode(inst, 0x100, nil, 0); out != tt.plan9 {
+			t.Errorf("Plan9SyntaxMode(%#08x, 0) = %q, want %q", tt.enc, out, tt.plan9)
+		}
+		if out := Plan9SyntaxMode(inst, 0x100, nil, ModeISANames); out != tt.names {
+			t.Errorf("Plan9SyntaxMode(%#08x, ModeISANames) = %q, want %q", tt.enc, out, tt.names)
+		}
+	}
+}
+
+// disasmSink keeps the benchmarks from discarding the text they print.
+var disasmSink string
+
+func TestDataWord(t *testing.T) {
+	code := []byte{
+		0x00, 0x00, 0x00, 0x00, // not an instruction
+		0x38, 0x60, 0x00, 0x64, // li r3,100
+		0x00, 0x00, 0x12, 0x34, // not an instruction
+		0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // prefix of an unknown suffix
+		0x7c, 0x00, // truncated
+	}
+	want := []string{
+		"0x1000 WORD $0x00000000",
+		"0x1004 MOVD $100, R3",
+		"0x1008 WORD $0x00001234",
+		"0x100c WORD $0x04000000",
+		"0x1010 WORD $0x00000000",
+		"0x1014 BYTE $0x7c",
+		"0x1015 BYTE $0x00",
+	}
+	var out []string
+	d := NewDisassembler(code, 0x1000, binary.BigEndian, nil)
+	for {
+		pc, text, ok := d.Next()
+		if !ok {
+			if pc != 0x1016 {
+				t.Errorf("Disassembler ended at pc %#x, want 0x1016", pc)
+			}
+			break
+		}
+		out = append(out, fmt.Sprintf("%#x %s", pc, text))
+	}
+	if strings.Join(out, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Disassembler:\n%s\nwant:\n%s", strings.Join(out, "\n"), strings.Join(want, "\n"))
+	}
+	if s := GNUDataWord(0x7c00); s != ".long 0x7c00" {
+		t.Errorf("GNUDataWord(0x7c00) = %s, want .long 0x7c00", s)
+	}
+}
+
+func BenchmarkDisassembler(b *testing.B) {
+	code := disasmCode(b)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(code)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := NewDisassembler(code, 0x1000, binary.BigEndian, benchSymname)
+		for {
+			_, text, ok := d.Next()
+			if !ok {
+				break
+			}
+			disasmSink = text
+		}
+	}
+}
+
+func BenchmarkDisassemblerAppendNext(b *testing.B) {
+	code := disasmCode(b)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(code)))
+	b.ResetTimer()
+	var buf []byte
+	for i := 0; i < b.N; i++ {
+		d := NewDisassembler(code, 0x1000, binary.BigEndian, benchSymname)
+		for ok := true; ok; _, buf, ok = d.AppendNext(buf[:0]) {
+		}
+	}
+}
+
+// BenchmarkDecodePlan9 is BenchmarkDisassembler without a Disassembler.
+func BenchmarkDecodePlan9(b *testing.B) {
+	code := disasmCode(b)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(code)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ForEachInst(code, 0x1000, binary.BigEndian, func(pc uint64, inst Inst) {
+			disasmSink = Plan9Syntax(inst, pc, benchSymname)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package power64asm
+
+import "encoding/binary"
+
+// A Disassembler prints the instructions in a block of machine code in
+// Go assembler syntax, one at a time, keeping track of the pc. It prints
+// the same text as calling Decode and Plan9Syntax on each instruction,
+// except that the words that do not decode print as data directives.
+// It remembers recent symbol lookups, so a loop that branches to the
+// same targets again and again consults the symbol table about once per
+// target, and AppendNext formats each instruction into a buffer the
+// caller reuses, without allocating for the text.
+type Disassembler struct {
+	src     []byte
+	pc      uint64
+	ord     binary.ByteOrder
+	symname SymLookup
+	lookup  SymLookup // symname through cache, or nil
+	buf     []byte
+	cache   [symCacheSize]symCacheEntry
+}
+
+// hexDigits are the digits of the WORD and BYTE directives, which print
+// two for each byte.
+const hexDigits = "0123456789abcdef"
+
+// symCacheSize is the number of symbol lookups a Disassembler remembers.
+const symCacheSize = 64
+
+type symCacheEntry struct {
+	addr  uint64
+	valid bool
+	name  string
+	base  uint64
+}
+
+// NewDisassembler returns a Disassembler for the machine code src,
+// which is loaded at address pc, using byte order ord.
+// The symname function, which may be nil, is as for Plan9Syntax.
+func NewDisassembler(src []byte, pc uint64, ord binary.ByteOrder, symname SymLookup) *Disassembler {
+	d := &Disassembler{src: src, pc: pc, ord: ord, symname: symname}
+	if symname != nil {
+		d.lookup = d.cachedLookup
+	}
+	return d
+}
+
+// Next disassembles the next instruction, returning its address and text.
+// It returns ok == false when there are no more instructions.
+// A word that does not decode prints as the directive DataWord, so that
+// the text still assembles to the same bytes, and disassembly resumes
+// with the next word. Bytes left over at the end of the code that are too
+// few for a word print as one BYTE directive each, like BYTE $0x7c.
+func (d *Disassembler) Next() (pc uint64, text string, ok bool) {
+	pc, d.buf, ok = d.AppendNext(d.buf[:0])
+	return pc, string(d.buf), ok
+}
+
+// AppendNext is like Next, but appends the text of the instruction to dst
+// and returns the extended buffer, which is dst unchanged when there are
+// no more instructions. A loop that passes the buffer back, truncated,
+// for the next instruction allocates nothing for the text.
+func (d *Disassembler) AppendNext(dst []byte) (pc uint64, text []byte, ok bool) {
+	if len(d.src) == 0 {
+		return d.pc, dst, false
+	}
+	inst := decodeNext(d.src, d.ord)
+	switch {
+	case inst.Op != 0:
+		dst = appendPlan9(dst, inst, d.pc, d.lookup, ModeGAlias)
+	case len(d.src) >= 4:
+		dst = appendDataWord(dst, inst.Enc)
+	default:
+		inst.Len = 1
+		b := d.src[0]
+		dst = append(dst, "BYTE $0x"...)
+		dst = append(dst, hexDigits[b>>4], hexDigits[b&0xf])
+	}
+	pc = d.pc
+	d.src = d.src[inst.Len:]
+	d.pc += uint64(inst.Len)
+	return pc, dst, true
+}
+
+// cachedLookup is d.symname, remembering the results for recent addresses.
+func (d *Disassembler) cachedLookup(addr uint64) (string, uint64) {
+	e := &d.cache[addr>>2%symCacheSize]
+	if !e.valid || e.addr != addr {
+		e.name, e.base = d.symname(addr)
+		e.addr, e.valid = addr, true
+	}
+	return e.name, e.base
+}
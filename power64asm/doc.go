@@ -2,5 +2,5 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package power64asm implements decoding of 64-bit Power machine code.
+// Package power64asm implements decoding and encoding of 64-bit Power machine code.
 package power64asm
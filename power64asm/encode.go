@@ -0,0 +1,213 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package power64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// Encode returns the machine code for i using byte order byteOrder,
+// the inverse of Decode. The instruction is encoded from i.Op and i.Args,
+// so it can be built from scratch, for example
+// Inst{Op: B, Args: Args{PCRel(0x100)}} for a branch to .+0x100.
+// Len is ignored, and Enc and SuffixEnc only choose between the forms of
+// instructions that decode the same from several encodings, like tlbilx,
+// whose T field is not an argument.
+// The result is 8 bytes for a prefixed instruction and 4 bytes otherwise,
+// and its bits outside the opcode and argument fields, such as reserved
+// and don't care bits, are zero.
+//
+// Encode returns an error if i.Op is not a known instruction, or if no
+// form of i.Op takes arguments of the types of i.Args, or an argument
+// does not fit its field, like an odd displacement in a branch.
+//
+// If the encoding of i decodes as another instruction, like addi with
+// RA of R0, which Decode returns as li, Encode still returns it.
+// SPE instructions are encoded as DecodeSPE decodes them.
+func (i Inst) Encode(byteOrder binary.ByteOrder) ([]byte, error) {
+	forms := encoderIndex()[i.Op]
+	if len(forms) == 0 {
+		return nil, fmt.Errorf("cannot encode %v: unknown instruction", i)
+	}
+	var exact, other []byte
+	err := fmt.Errorf("cannot encode %v: arguments do not match any form of %v", i, i.Op)
+	ui := uint64(i.Enc)<<32 | uint64(i.SuffixEnc)
+	dec := Decode
+	if i.Op.flags()&flagSPE != 0 {
+		dec = DecodeSPE
+	}
+	for _, n := range forms {
+		iform := &instFormats[n]
+		src, ok := iform.encode(i.Args, byteOrder)
+		if !ok {
+			continue
+		}
+		// Decode whatever was encoded, to reject bad encodings such as
+		// an odd register in a register pair, and to prefer the form it
+		// decodes back from: some instructions have several forms.
+		inst, derr := dec(src, byteOrder)
+		if derr != nil {
+			err = fmt.Errorf("cannot encode %v: %v", i, derr.(*DecodeError).Reason)
+			continue
+		}
+		switch {
+		case inst.Op != i.Op || inst.Args != i.Args:
+			if other == nil {
+				other = src
+			}
+		case ui&iform.Mask == iform.Value:
+			return src, nil
+		case exact == nil:
+			exact = src
+		}
+	}
+	if exact != nil {
+		return exact, nil
+	}
+	if other != nil {
+		return other, nil
+	}
+	return nil, err
+}
+
+// encode returns the instruction of form iform with arguments args,
+// or ok == false if args are not the arguments of iform.
+func (iform *instFormat) encode(args Args, ord binary.ByteOrder) (src []byte, ok bool) {
+	var words, used [2]uint32 // the argument fields, and the bits they occupy
+	for j, arg := range args {
+		var a *argField
+		if j < len(iform.Args) {
+			a = iform.Args[j]
+		}
+		if a == nil || a.Type == TypeUnknown {
+			if arg != nil {
+				return nil, false
+			}
+			continue
+		}
+		u, ok := a.encode(arg)
+		if !ok {
+			return nil, false
+		}
+		a.BitFields.place(&words, u)
+		a.BitFields.place(&used, 1<<a.BitFields.bits()-1)
+	}
+	// an argument may share fixed bits with the opcode, like the leading 1
+	// of the offset of hashst, which it must not change
+	ui := uint64(words[0])<<32 | uint64(words[1])
+	mask := (uint64(used[0])<<32 | uint64(used[1])) & iform.Mask
+	if ui&mask != iform.Value&mask {
+		return nil, false
+	}
+	ui |= iform.Value
+	if ui>>58 == prefixOpcode {
+		src = make([]byte, 8)
+		ord.PutUint32(src[4:], uint32(ui))
+	} else {
+		src = make([]byte, 4)
+	}
+	ord.PutUint32(src, uint32(ui>>32))
+	return src, true
+}
+
+// encode returns the value of the bit fields of a that holds arg,
+// or ok == false if arg is of the wrong type or does not fit.
+func (a argField) encode(arg Arg) (u uint64, ok bool) {
+	var v int64 // the number to store, before the Shift
+	signed := false
+	switch arg := arg.(type) {
+	case Reg:
+		var base, last Reg
+		switch a.Type {
+		case TypeReg:
+			base, last = R0, R31
+		case TypeFPReg:
+			base, last = F0, F31
+		case TypeVecReg:
+			base, last = V0, V31
+		case TypeVecSReg:
+			base, last = VS0, VS63
+		default:
+			return 0, false
+		}
+		if arg < base || arg > last {
+			return 0, false
+		}
+		v = int64(arg - base)
+	case CondReg:
+		switch {
+		case a.Type == TypeCondRegBit && Cond0LT <= arg && arg <= Cond7SO:
+			v = int64(arg - Cond0LT)
+		case a.Type == TypeCondRegField && CR0 <= arg && arg <= CR7:
+			v = int64(arg - CR0)
+		default:
+			return 0, false
+		}
+	case SpReg:
+		if a.Type != TypeSpReg {
+			return 0, false
+		}
+		v = int64(arg)
+	case ACCReg:
+		if a.Type != TypeACCReg || arg > ACC7 {
+			return 0, false
+		}
+		v = int64(arg - ACC0)
+	case Imm:
+		if a.Type != TypeImmSigned && a.Type != TypeImmUnsigned {
+			return 0, false
+		}
+		v, signed = int64(arg), a.Type == TypeImmSigned
+	case PCRel:
+		if a.Type != TypePCRel {
+			return 0, false
+		}
+		v, signed = int64(arg), true
+	case Label:
+		if a.Type != TypeLabel {
+			return 0, false
+		}
+		v, signed = int64(int32(arg)), true // Decode sign-extends the field
+	case Offset:
+		if a.Type != TypeOffset && a.Type != TypeOffsetUnsigned {
+			return 0, false
+		}
+		v, signed = int64(arg), a.Type == TypeOffset
+	default:
+		return 0, false
+	}
+	if v&(1<<a.Shift-1) != 0 {
+		return 0, false
+	}
+	v >>= a.Shift
+	bits := a.BitFields.bits()
+	if signed {
+		if v < -1<<(bits-1) || v >= 1<<(bits-1) {
+			return 0, false
+		}
+	} else if v < 0 || v >= 1<<bits {
+		return 0, false
+	}
+	return uint64(v) & (1<<bits - 1), true
+}
+
+// encoderIndex returns the indexes in instFormats of the forms of each Op,
+// in table order.
+func encoderIndex() map[Op][]uint16 {
+	encoderIndexOnce.Do(func() {
+		encoderForms = make(map[Op][]uint16)
+		for n, iform := range instFormats {
+			encoderForms[iform.Op] = append(encoderForms[iform.Op], uint16(n))
+		}
+	})
+	return encoderForms
+}
+
+var (
+	encoderIndexOnce sync.Once
+	encoderForms     map[Op][]uint16
+)
@@ -136,7 +136,7 @@ func testExtDis(
 			t.Errorf("decoding stream ended early")
 			return
 		}
-		inst, text := disasm(syntax, pad(enc))
+		inst, text := disasm(syntax, uint64(dec.addr), pad(enc))
 		totalTests++
 		if *dumpTest {
 			fmt.Printf("%x -> %s [%d]\n", enc[:len(enc)], dec.text, dec.nenc)
@@ -176,7 +176,7 @@ func testExtDis(
 	t.Logf("%d test cases, %d expected mismatches, %d failures; %.0f cases/second", totalTests, totalSkips, totalErrors, float64(totalTests)/time.Since(start).Seconds())
 
 	if err := <-errc; err != nil {
-		t.Fatal("external disassembler: %v", err)
+		t.Fatalf("external disassembler: %v", err)
 	}
 
 }
@@ -223,8 +223,8 @@ func pad(enc []byte) []byte {
 }
 
 // disasm returns the decoded instruction and text
-// for the given source bytes, using the given syntax and mode.
-func disasm(syntax string, src []byte) (inst Inst, text string) {
+// for the given source bytes at address pc, using the given syntax and mode.
+func disasm(syntax string, pc uint64, src []byte) (inst Inst, text string) {
 	// If printTests is set, we record the coverage value
 	// before and after, and we write out the inputs for which
 	// coverage went up, in the format expected in testdata/decode.text.
@@ -244,9 +244,9 @@ func disasm(syntax string, src []byte) (inst Inst, text string) {
 		//case "arm":
 		//	text = ARMSyntax(inst)
 		case "gnu":
-			text = GNUSyntax(inst)
-		//case "plan9":
-		//	text = plan9Syntax(inst, 0, nil)
+			text = GNUSyntax(inst, pc)
+		case "plan9":
+			text = Plan9Syntax(inst, pc, nil)
 		default:
 			text = "error: unknown syntax " + syntax
 		}
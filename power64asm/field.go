@@ -14,6 +14,7 @@ import (
 type BitField struct {
 	Offs uint8 // the offset of the left-most bit.
 	Bits uint8 // length in bits.
+	Word uint8 // the word holding the field: 0 for the prefix (or only) word, 1 for the suffix.
 }
 
 func (b BitField) String() string {
@@ -57,13 +58,14 @@ func (bs *BitFields) Append(b BitField) {
 	*bs = append(*bs, b)
 }
 
-// parse extracts the bitfields from i, concatenate them and return the result
-// as an unsigned integer and the total length of all the bitfields.
+// parse extracts the bitfields from the instruction words i, concatenate them
+// and return the result as an unsigned integer and the total length of all the
+// bitfields. Each bitfield is taken from the word selected by its Word.
 // parse will panic if any bitfield in b is invalid, but it doesn't check if
 // the sequence of bitfields is reasonable.
-func (bs BitFields) parse(i uint32) (u uint32, Bits uint8) {
+func (bs BitFields) parse(i [2]uint32) (u uint64, Bits uint8) {
 	for _, b := range bs {
-		u = (u << b.Bits) | b.Parse(i)
+		u = (u << b.Bits) | uint64(b.Parse(i[b.Word]))
 		Bits += b.Bits
 	}
 	return u, Bits
@@ -71,14 +73,33 @@ func (bs BitFields) parse(i uint32) (u uint32, Bits uint8) {
 
 // Parse extracts the bitfields from i, concatenate them and return the result
 // as an unsigned integer. Parse will panic if any bitfield in b is invalid.
-func (bs BitFields) Parse(i uint32) uint32 {
+func (bs BitFields) Parse(i [2]uint32) uint64 {
 	u, _ := bs.parse(i)
 	return u
 }
 
 // Parse extracts the bitfields from i, concatenate them and return the result
 // as a signed integer. Parse will panic if any bitfield in b is invalid.
-func (bs BitFields) ParseSigned(i uint32) int32 {
+func (bs BitFields) ParseSigned(i [2]uint32) int64 {
 	u, l := bs.parse(i)
-	return int32(u) << (32 - l) >> (32 - l)
+	return int64(u) << (64 - l) >> (64 - l)
+}
+
+// bits returns the total length of the bitfields in bs.
+func (bs BitFields) bits() uint8 {
+	var n uint8
+	for _, b := range bs {
+		n += b.Bits
+	}
+	return n
+}
+
+// place stores u, an unsigned integer of bs.bits() bits, in the bitfields of
+// the instruction words i, the inverse of parse. The bitfields must be zero.
+func (bs BitFields) place(i *[2]uint32, u uint64) {
+	for k := len(bs) - 1; k >= 0; k-- {
+		b := bs[k]
+		i[b.Word] |= uint32(u&(1<<b.Bits-1)) << (32 - b.Offs - b.Bits)
+		u >>= b.Bits
+	}
 }
@@ -26,15 +26,15 @@ func TestBitField(t *testing.T) {
 		s    int32  // signed output
 		fail bool   // if the check should panic
 	}{
-		{BitField{0, 0}, 0, 0, 0, true},
-		{BitField{31, 2}, 0, 0, 0, true},
-		{BitField{31, 1}, 1, 1, -1, false},
-		{BitField{29, 2}, 0 << 1, 0, 0, false},
-		{BitField{29, 2}, 1 << 1, 1, 1, false},
-		{BitField{29, 2}, 2 << 1, 2, -2, false},
-		{BitField{29, 2}, 3 << 1, 3, -1, false},
-		{BitField{0, 32}, 1<<32 - 1, 1<<32 - 1, -1, false},
-		{BitField{16, 3}, 1 << 15, 4, -4, false},
+		{BitField{0, 0, 0}, 0, 0, 0, true},
+		{BitField{31, 2, 0}, 0, 0, 0, true},
+		{BitField{31, 1, 0}, 1, 1, -1, false},
+		{BitField{29, 2, 0}, 0 << 1, 0, 0, false},
+		{BitField{29, 2, 0}, 1 << 1, 1, 1, false},
+		{BitField{29, 2, 0}, 2 << 1, 2, -2, false},
+		{BitField{29, 2, 0}, 3 << 1, 3, -1, false},
+		{BitField{0, 32, 0}, 1<<32 - 1, 1<<32 - 1, -1, false},
+		{BitField{16, 3, 0}, 1 << 15, 4, -4, false},
 	}
 	for i, tst := range tests {
 		var (
@@ -58,3 +58,29 @@ func TestBitField(t *testing.T) {
 		}
 	}
 }
+
+func TestBitFields(t *testing.T) {
+	var tests = []struct {
+		b BitFields
+		i [2]uint32 // input
+		u uint64    // unsigned output
+		s int64     // signed output
+	}{
+		{BitFields{{16, 16, 0}}, [2]uint32{0xfff8, 0}, 0xfff8, -8},
+		{BitFields{{16, 16, 0}}, [2]uint32{0x8000, 0}, 0x8000, -32768},
+		{BitFields{{16, 16, 0}}, [2]uint32{0x7fff, 0}, 0x7fff, 32767},
+		{BitFields{{16, 14, 0}}, [2]uint32{0xfff8, 0}, 0x3ffe, -2}, // DS field, scaled by 4 when decoded
+		{BitFields{{30, 1, 0}, {16, 5, 0}}, [2]uint32{0xf802, 0}, 0x3f, -1},
+		{BitFields{{14, 18, 0}, {16, 16, 1}}, [2]uint32{0x3ffff, 0xfffc}, 1<<34 - 4, -4},
+		{BitFields{{14, 18, 0}, {16, 16, 1}}, [2]uint32{0x20000, 0}, 1 << 33, -1 << 33},
+		{BitFields{{16, 16, 1}}, [2]uint32{0xffffffff, 0x7fff}, 0x7fff, 0x7fff},
+	}
+	for i, tst := range tests {
+		if u := tst.b.Parse(tst.i); u != tst.u {
+			t.Errorf("case %d: %v.Parse(%#x) returned %#x, expected %#x", i, tst.b, tst.i, u, tst.u)
+		}
+		if s := tst.b.ParseSigned(tst.i); s != tst.s {
+			t.Errorf("case %d: %v.ParseSigned(%#x) returned %d, expected %d", i, tst.b, tst.i, s, tst.s)
+		}
+	}
+}
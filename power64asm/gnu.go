@@ -10,20 +10,30 @@ import (
 	"strings"
 )
 
+// GNUDataWord returns the GNU assembler directive for the word enc,
+// such as .long 0x0, which objdump prints for a word that does not decode.
+func GNUDataWord(enc uint32) string {
+	return fmt.Sprintf(".long 0x%x", enc)
+}
+
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This form typically matches the syntax defined in the Power ISA Reference Manual.
-func GNUSyntax(inst Inst) string {
+// The pc is the program counter of the instruction, used for expanding
+// PC-relative addresses into absolute ones.
+func GNUSyntax(inst Inst, pc uint64) string {
 	var buf bytes.Buffer
 	if inst.Op == 0 {
 		return "error: unkown instruction"
 	}
+	if s := gnuExtendedOp(inst, pc); s != "" {
+		return s
+	}
 	buf.WriteString(inst.Op.String())
 	sep := " "
-	for i, arg := range inst.Args[:] {
-		if arg == nil {
-			break
-		}
-		text := gnuArg(&inst, i, arg)
+	// gnuArg may remove arguments it has folded into a memory operand,
+	// so recount them on every iteration.
+	for i := 0; i < inst.NumArgs(); i++ {
+		text := gnuArg(&inst, i, inst.Args[i], pc)
 		if text == "" {
 			continue
 		}
@@ -34,43 +44,275 @@ func GNUSyntax(inst Inst) string {
 	return buf.String()
 }
 
-// gnuArg formats arg (which is the argIndex's arg in inst) according to GNU rules.
-// NOTE: because GNUSyntax is the only caller of this func, and it receives a copy
-//       of inst, it's ok to modify inst.Args here.
-func gnuArg(inst *Inst, argIndex int, arg Arg) string {
-	// special cases for load/store instructions
-	if _, ok := arg.(Offset); ok {
-		if argIndex+1 == len(inst.Args) || inst.Args[argIndex+1] == nil {
-			panic(fmt.Errorf("wrong table: offset not followed by register"))
+// GNUSyntaxSym is like GNUSyntax, but prints a call to the start of a
+// symbol found by symname with the name of the symbol, like bl memcpy.
+func GNUSyntaxSym(inst Inst, pc uint64, symname SymLookup) string {
+	if name, ok := inst.CallTarget(pc, symname); ok {
+		return inst.Op.String() + " " + name
+	}
+	return GNUSyntax(inst, pc)
+}
+
+// gnuExtendedOp returns the extended mnemonic form binutils uses for inst
+// at pc, or "" if inst should be printed in its basic form.
+func gnuExtendedOp(inst Inst, pc uint64) string {
+	if name := nopName(inst); name != "" {
+		return name
+	}
+	if name := syncName(inst); name != "" {
+		return name
+	}
+	switch inst.Op {
+	case OR, OR_, NOR, NOR_:
+		if inst.Args[1] == inst.Args[2] { // mr, not
+			name := "mr"
+			if inst.Op == NOR || inst.Op == NOR_ {
+				name = "not"
+			}
+			if inst.Op == OR_ || inst.Op == NOR_ {
+				name += "."
+			}
+			return name + " " + gnuArg(&inst, 0, inst.Args[0], pc) + "," + gnuArg(&inst, 1, inst.Args[1], pc)
+		}
+		return ""
+	case ADDPCIS:
+		if inst.Args[1].(Imm) == 0 {
+			return "lnia " + gnuArg(&inst, 0, inst.Args[0], pc)
+		}
+		return ""
+	// moves to and from the SPRs binutils names, like mflr and mtctr
+	case MFSPR:
+		if name := gnuSpRegName(inst.Args[1].(SpReg), false); name != "" {
+			return "mf" + name + " " + gnuArg(&inst, 0, inst.Args[0], pc)
+		}
+		return ""
+	case MTSPR:
+		if name := gnuSpRegName(inst.Args[0].(SpReg), true); name != "" {
+			return "mt" + name + " " + gnuArg(&inst, 1, inst.Args[1], pc)
+		}
+		return ""
+	// the transactional memory forms binutils names
+	case TEND_:
+		if inst.Args[0] == Imm(1) { // A=1 ends all nested transactions
+			return "tendall."
+		}
+		return ""
+	case TSR_:
+		if inst.Args[0] == Imm(1) {
+			return "tresume."
+		}
+		return "tsuspend."
+	case TW, TD, TWI, TDI:
+		name := trapName(inst)
+		if name == "" || name == "trap" {
+			return name
+		}
+		return name + " " + gnuArg(&inst, 1, inst.Args[1], pc) + "," + gnuArg(&inst, 2, inst.Args[2], pc)
+	// 64-bit rotates with no mask or no shift
+	case RLDICL, RLDICL_, RLDCL, RLDCL_:
+		name, n := "rotldi", 2
+		if inst.Op == RLDCL || inst.Op == RLDCL_ {
+			name = "rotld"
+		}
+		switch {
+		case inst.Args[3] == Imm(0): // rotate left
+		case name == "rotldi" && inst.Args[2] == Imm(0): // clear left
+			name, n = "clrldi", 3
+		default:
+			return ""
+		}
+		if inst.Op == RLDICL_ || inst.Op == RLDCL_ {
+			name += "."
+		}
+		return name + " " + gnuArg(&inst, 0, inst.Args[0], pc) + "," + gnuArg(&inst, 1, inst.Args[1], pc) + "," + gnuArg(&inst, n, inst.Args[n], pc)
+	case XXPERMDI:
+		xt, xa, xb := gnuArg(&inst, 0, inst.Args[0], pc), gnuArg(&inst, 1, inst.Args[1], pc), gnuArg(&inst, 2, inst.Args[2], pc)
+		dm := inst.Args[3].(Imm)
+		switch {
+		case inst.Args[1] == inst.Args[2] && (dm == 0 || dm == 3):
+			return fmt.Sprintf("xxspltd %s,%s,%d", xt, xa, dm&1)
+		case inst.Args[1] == inst.Args[2] && dm == 2:
+			return "xxswapd " + xt + "," + xa
+		case dm == 0:
+			return "xxmrghd " + xt + "," + xa + "," + xb
+		case dm == 3:
+			return "xxmrgld " + xt + "," + xa + "," + xb
+		}
+		return ""
+	}
+	suffix, ok := gnuBranchSuffix[inst.Op]
+	if !ok {
+		return ""
+	}
+	bo, hint, ok := branchHint(int(inst.Args[0].(Imm)))
+	if !ok {
+		return ""
+	}
+	bi := inst.Args[1].(CondReg)
+	var args []string
+	switch inst.Op {
+	case BCLR, BCLRL, BCCTR, BCCTRL, BCTAR, BCTARL:
+		if inst.Args[2].(Imm) != 0 {
+			return "" // has a BH hint
 		}
+	default:
+		args = append(args, gnuArg(&inst, 2, inst.Args[2], pc))
 	}
+	var name string
+	switch {
+	case bo == 20: // branch always
+		if len(args) > 0 {
+			return ""
+		}
+		return "b" + suffix
+	case bo == 12: // branch if CR bit set
+		name = "b" + [4]string{"lt", "gt", "eq", "so"}[bi.Bit()] + suffix + hint
+	case bo == 4: // branch if CR bit clear
+		name = "b" + [4]string{"ge", "le", "ne", "ns"}[bi.Bit()] + suffix + hint
+	case bo == 16 && bi == Cond0LT && inst.Op != BCCTR && inst.Op != BCCTRL: // decrement CTR, branch if CTR != 0
+		return strings.TrimSpace("bdnz" + suffix + hint + " " + strings.Join(args, ","))
+	case bo == 18 && bi == Cond0LT && inst.Op != BCCTR && inst.Op != BCCTRL: // decrement CTR, branch if CTR == 0
+		return strings.TrimSpace("bdz" + suffix + hint + " " + strings.Join(args, ","))
+	default:
+		return ""
+	}
+	if bi.Field() != 0 {
+		args = append([]string{fmt.Sprintf("cr%d", bi.Field())}, args...)
+	}
+	return strings.TrimSpace(name + " " + strings.Join(args, ","))
+}
+
+// gnuBranchSuffix maps a conditional branch to the suffix of its extended mnemonics.
+var gnuBranchSuffix = map[Op]string{
+	BC: "", BCA: "a", BCL: "l", BCLA: "la",
+	BCLR: "lr", BCLRL: "lrl",
+	BCCTR: "ctr", BCCTRL: "ctrl",
+	BCTAR: "tar", BCTARL: "tarl",
+}
+
+// branchHint splits the BO field of a conditional branch into the BO
+// field without its branch prediction hint and the hint, which is
+// "+" if the branch is likely to be taken, "-" if it is likely not to be,
+// or "" if there is no hint. The hint is held in the at bits of BO: the
+// last two bits of a branch on a CR bit (0b001at, 0b011at) or the second
+// and last bits of a branch on CTR (0b1a00t, 0b1a01t). It returns ok == false
+// if the hint bits hold the reserved value 0b01.
+func branchHint(bo int) (base int, hint string, ok bool) {
+	var at int
+	switch {
+	case bo&0x14 == 0x04: // branch on a CR bit
+		at, base = bo&3, bo&^3
+	case bo&0x14 == 0x10: // branch on CTR
+		at, base = bo>>2&2|bo&1, bo&^0x9
+	default:
+		return bo, "", true
+	}
+	return base, [4]string{"", "", "-", "+"}[at], at != 1
+}
+
+// gnuSpRegName returns the name binutils gives spr in the extended
+// mnemonics of mfspr and, if write is set, mtspr, or "" if it has none.
+// The PVR is read-only, and TBL and TBU name the time base only for
+// writing, so there are mfpvr, mttbl and mttbu but no mtpvr or mftbl.
+func gnuSpRegName(spr SpReg, write bool) string {
+	switch spr {
+	case 287: // PVR
+		if write {
+			return ""
+		}
+	case 284, 285: // TBL, TBU
+		if !write {
+			return ""
+		}
+	}
+	return gnuSpRegNames[spr]
+}
+
+// gnuSpRegNames maps the numbers of the SPRs that binutils moves to and
+// from with extended mnemonics, like mflr and mtctr, to their names.
+var gnuSpRegNames = map[SpReg]string{
+	1:   "xer",
+	3:   "udscr",
+	8:   "lr",
+	9:   "ctr",
+	13:  "uamr",
+	17:  "dscr",
+	18:  "dsisr",
+	19:  "dar",
+	22:  "dec",
+	25:  "sdr1",
+	26:  "srr0",
+	27:  "srr1",
+	28:  "cfar",
+	29:  "amr",
+	256: "vrsave",
+	284: "tbl",
+	285: "tbu",
+	287: "pvr",
+	896: "ppr",
+}
+
+// gnuArg formats arg (which is the argIndex's arg in inst) according to GNU rules.
+// NOTE: because GNUSyntax is the only caller of this func, and it receives a copy
+// of inst, it's ok to modify inst.Args here.
+func gnuArg(inst *Inst, argIndex int, arg Arg, pc uint64) string {
 	switch arg := arg.(type) {
 	case Reg:
-		if isLoadStoreOp(inst.Op) && argIndex == 1 && arg == R0 {
+		if arg == R0 && inst.Op.isRAZeroArg(argIndex) {
 			return "0"
 		}
-		return arg.String()
+		return strings.ToLower(arg.String())
+	case ACCReg:
+		return fmt.Sprintf("a%d", int(arg-ACC0))
 	case CondReg:
-		if arg == CR0 && strings.HasPrefix(inst.Op.String(), "cmp") {
+		if arg == CR0 && isCompareOp(inst.Op) && argIndex == 0 {
 			return "" // don't show cr0 for cmp instructions
-		} else if arg >= CR0 {
-			return fmt.Sprintf("cr%d", int(arg-CR0))
+		} else if arg.Bit() < 0 && (inst.Op == MTFSFI || inst.Op == MTFSFI_) {
+			return fmt.Sprintf("%d", arg.Field()) // an FPSCR field
+		} else if arg.Bit() < 0 {
+			return fmt.Sprintf("cr%d", arg.Field())
 		}
-		bit := [4]string{"lt", "gt", "eq", "so"}[(arg-Cond0LT)%4]
-		if arg <= Cond0SO {
+		bit := [4]string{"lt", "gt", "eq", "so"}[arg.Bit()]
+		if arg.Field() == 0 {
 			return bit
 		}
-		return fmt.Sprintf("4*cr%d+%s", int(arg-Cond0LT)/4, bit)
+		return fmt.Sprintf("4*cr%d+%s", arg.Field(), bit)
 	case Imm:
+		if arg == 0 && hasPrefixedR(inst.Op) && isLastArg(inst, argIndex) {
+			return "" // R=0 is implied
+		}
+		switch inst.Op {
+		case MTFSF, MTFSF_: // FLM,FRB[,L,W]
+			if argIndex >= 2 && inst.Args[3] == Imm(0) && (argIndex == 3 || arg == 0) {
+				return "" // L and W are optional
+			}
+		case MTFSFI, MTFSFI_: // BF,U[,W]
+			if argIndex == 2 && arg == 0 {
+				return ""
+			}
+		case XXSPLTIB: // binutils prints the byte IMM8 unsigned
+			return fmt.Sprintf("%d", uint8(arg))
+		}
+		if hasOptionalImm(inst.Op) && isZeroTail(inst, argIndex) {
+			return ""
+		}
 		return fmt.Sprintf("%d", arg)
 	case SpReg:
 		return fmt.Sprintf("%d", int(arg))
 	case PCRel:
-		return fmt.Sprintf(".%+#x", int(arg))
+		return fmt.Sprintf("%#x", pc+uint64(int64(arg)))
 	case Label:
-		return fmt.Sprintf("%#x", int(arg))
+		// sign-extended, as for BranchTarget
+		return fmt.Sprintf("%#x", uint64(int64(int32(arg))))
 	case Offset:
-		reg := inst.Args[argIndex+1].(Reg)
+		// Decode ensures an offset is followed by its base register
+		var reg Reg
+		if argIndex+1 < len(inst.Args) {
+			reg, _ = inst.Args[argIndex+1].(Reg)
+		}
+		if reg == 0 {
+			break
+		}
 		removeArg(inst, argIndex+1)
 		if reg == R0 {
 			return fmt.Sprintf("%d(0)", int(arg))
@@ -80,8 +322,12 @@ func gnuArg(inst *Inst, argIndex int, arg Arg) string {
 	return fmt.Sprintf("???(%v)", arg)
 }
 
-// removeArg removes the arg in inst.Args[index].
+// removeArg removes the arg in inst.Args[index], shifting the later args down.
+// It does nothing if index is out of range.
 func removeArg(inst *Inst, index int) {
+	if index < 0 || index >= len(inst.Args) {
+		return
+	}
 	for i := index; i < len(inst.Args); i++ {
 		if i+1 < len(inst.Args) {
 			inst.Args[i] = inst.Args[i+1]
@@ -91,34 +337,159 @@ func removeArg(inst *Inst, index int) {
 	}
 }
 
-// isLoadStoreOp returns true if op is a load or store instruction
-func isLoadStoreOp(op Op) bool {
+// hasPrefixedR reports whether op is a prefixed instruction whose final
+// argument is the R bit, which selects PC-relative addressing when set.
+func hasPrefixedR(op Op) bool {
 	switch op {
-	case LBZ, LBZU, LBZX, LBZUX:
-		return true
-	case LHZ, LHZU, LHZX, LHZUX:
-		return true
-	case LHA, LHAU, LHAX, LHAUX:
-		return true
-	case LWZ, LWZU, LWZX, LWZUX:
-		return true
-	case LWA, LWAX, LWAUX:
-		return true
-	case LD, LDU, LDX, LDUX:
-		return true
-	case LQ:
+	case PADDI, PLBZ, PLHZ, PLHA, PLWZ, PLWA, PLD, PSTB, PSTH, PSTW, PSTD,
+		PLFS, PLFD, PSTFS, PSTFD, PLXSD, PLXSSP, PSTXSD, PSTXSSP, PLXV, PSTXV, PLXVP, PSTXVP:
 		return true
-	case STB, STBU, STBX, STBUX:
-		return true
-	case STH, STHU, STHX, STHUX:
-		return true
-	case STW, STWU, STWX, STWUX:
-		return true
-	case STD, STDU, STDX, STDUX:
+	}
+	return false
+}
+
+// isPCRelPrefixed reports whether inst is a prefixed instruction with the
+// R bit set, which adds its displacement to the address of the instruction
+// instead of to RA, which must then be 0.
+func isPCRelPrefixed(inst *Inst) bool {
+	return hasPrefixedR(inst.Op) && inst.Args[inst.NumArgs()-1] == Imm(1)
+}
+
+// hasOptionalImm reports whether the trailing immediate arguments of op are
+// omitted when they are 0, like the LEV of sc, the L of mtmsrd, the TH of dcbt,
+// the WC and PL of wait, and the R of tbegin., A of tend. and L of tsr. in
+// transactional memory. A zero followed by a nonzero argument is printed.
+func hasOptionalImm(op Op) bool {
+	switch op {
+	case SC, SLBIA, DCBT, DCBTST, DCBF, MTMSR, MTMSRD, WAIT:
 		return true
-	case STQ:
+	case TBEGIN_, TEND_, TSR_:
 		return true
-	case LHBRX, LWBRX, STHBRX, STWBRX:
+	}
+	return false
+}
+
+// isZeroTail reports whether inst.Args[argIndex] and the arguments after it
+// are all the immediate 0.
+func isZeroTail(inst *Inst, argIndex int) bool {
+	for _, arg := range inst.Args[argIndex:] {
+		if arg != nil && arg != Imm(0) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLastArg reports whether inst.Args[argIndex] is the final argument of inst.
+func isLastArg(inst *Inst, argIndex int) bool {
+	return argIndex+1 == len(inst.Args) || inst.Args[argIndex+1] == nil
+}
+
+// nopName returns the no-op mnemonic of inst, or "" if inst is not one of
+// the no-op encodings: nop (ori 0,0,0), xnop (xori 0,0,0) or one of the
+// or Rx,Rx,Rx forms that set the thread priority, such as yield.
+func nopName(inst Inst) string {
+	switch inst.Op {
+	case ORI:
+		if inst.Args[0] == R0 && inst.Args[1] == R0 && inst.Args[2] == Imm(0) {
+			return "nop"
+		}
+	case XORI:
+		if inst.Args[0] == R0 && inst.Args[1] == R0 && inst.Args[2] == Imm(0) {
+			return "xnop"
+		}
+	case OR:
+		if r := inst.Args[0]; r == inst.Args[1] && r == inst.Args[2] {
+			return priorityNops[r.(Reg)]
+		}
+	}
+	return ""
+}
+
+// priorityNops maps Rx to the name binutils gives or Rx,Rx,Rx.
+var priorityNops = map[Reg]string{
+	R1:  "cctpl",
+	R2:  "cctpm",
+	R3:  "cctph",
+	R27: "yield",
+	R29: "mdoio",
+	R30: "mdoom",
+}
+
+// syncName returns the extended mnemonic for the memory barrier inst,
+// such as lwsync for sync 1,0, or "" if inst is not a sync with one.
+func syncName(inst Inst) string {
+	if inst.Op != SYNC {
+		return ""
+	}
+	l, sc := inst.Args[0].(Imm), inst.Args[1].(Imm)
+	if sc == 0 {
+		switch l {
+		case 0:
+			return "sync"
+		case 1:
+			return "lwsync"
+		case 2:
+			return "ptesync"
+		case 4:
+			return "phwsync"
+		case 5:
+			return "plwsync"
+		}
+	}
+	switch {
+	case l == 1 && sc == 1:
+		return "stncisync"
+	case l == 0 && sc == 2:
+		return "stcisync"
+	case l == 0 && sc == 3:
+		return "stsync"
+	}
+	return ""
+}
+
+// trapName returns the extended mnemonic of the trap instruction inst,
+// such as tweq or tdlgti, or "" if its TO field has none.
+// An unconditional tw with RA and RB both r0 is trap.
+func trapName(inst Inst) string {
+	to := int(inst.Args[0].(Imm))
+	if inst.Op == TW && to == 31 && inst.Args[1] == R0 && inst.Args[2] == R0 {
+		return "trap"
+	}
+	for i, op := range [...]Op{TW, TD, TWI, TDI} {
+		if inst.Op == op && 0 <= to && to < 32 {
+			return trapNames[i][to]
+		}
+	}
+	return ""
+}
+
+// trapNames holds the extended mnemonics of tw, td, twi and tdi, by TO field,
+// so that formatting a trap does not build its name.
+var trapNames = func() (names [4][32]string) {
+	for to, cond := range trapConds {
+		names[0][to] = "tw" + cond
+		names[1][to] = "td" + cond
+		names[2][to] = "tw" + cond + "i"
+		names[3][to] = "td" + cond + "i"
+	}
+	return names
+}()
+
+// trapConds maps a TO field to the condition of its extended trap mnemonics.
+// Where a TO value has synonyms, such as lge and lnl, it maps to the one
+// binutils prints.
+var trapConds = map[int]string{
+	1: "lgt", 2: "llt", 4: "eq", 5: "lge", 6: "lle",
+	8: "gt", 12: "ge", 16: "lt", 20: "le", 24: "ne",
+	31: "u",
+}
+
+// isCompareOp returns true if op is a fixed-point compare instruction,
+// whose BF operand defaults to cr0.
+func isCompareOp(op Op) bool {
+	switch op {
+	case CMPW, CMPD, CMPWI, CMPDI, CMPLW, CMPLD, CMPLWI, CMPLDI:
 		return true
 	}
 	return false
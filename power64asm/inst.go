@@ -6,23 +6,35 @@ package power64asm
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 )
 
+// An Inst is a single instruction, as returned by Decode.
+// Instructions are 4 bytes long, except for the Power10 prefixed
+// instructions, which are an 8-byte prefix and suffix word pair.
+// The next instruction therefore starts Len bytes after this one:
+// code disassembling a block of instructions must advance by Len,
+// as ForEachInst and Disassembler do, not by 4.
 type Inst struct {
-	Op   Op     // Opcode mnemonic
-	Enc  uint32 // Raw encoding bits
-	Len  int    // Length of encoding in bytes.
-	Args Args   // Instruction arguments, in Power ISA manual order.
+	Op        Op     // Opcode mnemonic
+	Enc       uint32 // Raw encoding bits (the prefix word of a prefixed instruction)
+	SuffixEnc uint32 // Raw encoding bits of the suffix word of a prefixed instruction
+	Len       int    // Length of encoding in bytes: 8 for a prefixed instruction, 4 otherwise.
+	Args      Args   // Instruction arguments, in Power ISA manual order.
 }
 
+// String returns a raw textual form of the instruction: the opcode mnemonic
+// followed by its arguments in Power ISA manual order. The zero Inst prints as "?".
 func (i Inst) String() string {
+	if i.Op == 0 {
+		return "?"
+	}
 	var buf bytes.Buffer
 	buf.WriteString(i.Op.String())
-	for j, arg := range i.Args {
-		if arg == nil {
-			break
-		}
+	for j, arg := range i.ActiveArgs() {
 		if j == 0 {
 			buf.WriteString(" ")
 		} else {
@@ -33,29 +45,466 @@ func (i Inst) String() string {
 	return buf.String()
 }
 
+// GoString returns a Go composite literal for the instruction, for %#v,
+// like Inst{Op: ADDI, Enc: 0x38640010, Len: 4, Args: Args{R3, R4, Imm(16)}}.
+// The literal uses the unqualified names of this package, so it can be
+// pasted into a test of the package and compares equal to i.
+// Zero fields and the trailing nil Args are omitted.
+func (i Inst) GoString() string {
+	var fields []string
+	if i.Op != 0 {
+		fields = append(fields, "Op: "+i.Op.goString())
+	}
+	if i.Enc != 0 {
+		fields = append(fields, fmt.Sprintf("Enc: 0x%08x", i.Enc))
+	}
+	if i.SuffixEnc != 0 {
+		fields = append(fields, fmt.Sprintf("SuffixEnc: 0x%08x", i.SuffixEnc))
+	}
+	if i.Len != 0 {
+		fields = append(fields, fmt.Sprintf("Len: %d", i.Len))
+	}
+	if args := i.ActiveArgs(); len(args) > 0 {
+		s := make([]string, len(args))
+		for j, arg := range args {
+			s[j] = argGoString(arg)
+		}
+		fields = append(fields, "Args: Args{"+strings.Join(s, ", ")+"}")
+	}
+	return "Inst{" + strings.Join(fields, ", ") + "}"
+}
+
+// argGoString returns a Go expression for arg, a constant name like R3 or
+// CR1 or a conversion like Imm(16).
+func argGoString(arg Arg) string {
+	switch arg := arg.(type) {
+	case Reg:
+		return arg.String() // R3 or Reg(200)
+	case CondReg:
+		return arg.String() // Cond0EQ, CR1 or CondReg(0)
+	case SpReg:
+		return arg.String() // SpReg(8)
+	case ACCReg:
+		return arg.String() // ACC1 or ACCReg(8)
+	case Label:
+		return fmt.Sprintf("Label(%#x)", uint32(arg))
+	case Imm:
+		return fmt.Sprintf("Imm(%d)", int64(arg))
+	case PCRel:
+		return fmt.Sprintf("PCRel(%d)", int64(arg))
+	case Offset:
+		return fmt.Sprintf("Offset(%d)", int64(arg))
+	}
+	return fmt.Sprintf("%#v", arg)
+}
+
+// MarshalJSON returns the instruction as a JSON object holding its
+// mnemonic, its arguments in Power ISA manual order and its length, like
+//
+//	{"op":"ld","args":[{"type":"reg","value":"R3","num":3},{"type":"offset","value":"+8","num":8},{"type":"reg","value":"R1","num":1}],"len":4}
+//
+// Each argument has a type tag, one of reg, cr, spr, acc, imm, pcrel,
+// label and offset, the String of the argument as its value, and a number:
+// the number of a register within its register file, the number of a CR
+// field or of a CR bit from 0 to 31, the SPR number, or the value of an
+// immediate, PC-relative offset, sign-extended label or memory offset.
+// An Arg of a type outside this package has type arg and no number.
+func (i Inst) MarshalJSON() ([]byte, error) {
+	args := i.ActiveArgs()
+	j := jsonInst{Op: i.Op.String(), Args: make([]jsonArg, len(args)), Len: i.Len}
+	for k, arg := range args {
+		j.Args[k] = argJSON(arg)
+	}
+	return json.Marshal(j)
+}
+
+type jsonInst struct {
+	Op   string    `json:"op"`
+	Args []jsonArg `json:"args"`
+	Len  int       `json:"len"`
+}
+
+type jsonArg struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Num   *int64 `json:"num,omitempty"`
+}
+
+// argJSON returns the JSON form of arg, as described at MarshalJSON.
+func argJSON(arg Arg) jsonArg {
+	var typ string
+	var n int64
+	switch arg := arg.(type) {
+	case Reg:
+		typ, n = "reg", int64(arg.Number())
+	case CondReg:
+		typ, n = "cr", int64(arg.Field())
+		if b := arg.Bit(); b >= 0 {
+			n = n*4 + int64(b)
+		}
+	case SpReg:
+		typ, n = "spr", int64(arg)
+	case ACCReg:
+		typ, n = "acc", int64(arg)
+	case Imm:
+		typ, n = "imm", int64(arg)
+	case PCRel:
+		typ, n = "pcrel", int64(arg)
+	case Label:
+		typ, n = "label", int64(int32(arg))
+	case Offset:
+		typ, n = "offset", int64(arg)
+	default:
+		return jsonArg{Type: "arg", Value: arg.String()}
+	}
+	return jsonArg{Type: typ, Value: arg.String(), Num: &n}
+}
+
+// PrimaryOp returns the primary opcode of the instruction, the top 6 bits of Enc.
+// It is 1 for every prefixed instruction; the primary opcode of the
+// suffix is the top 6 bits of SuffixEnc.
+func (i Inst) PrimaryOp() uint8 {
+	return uint8(i.Enc >> 26)
+}
+
+// CrossesBoundary reports whether i at address pc is a prefixed instruction
+// whose suffix is in the next 64-byte block, its prefix word being at
+// pc ≡ 60 mod 64. Power ISA 3.1 does not allow that: executing it causes an
+// alignment interrupt, so in code it is a sign of misaligned or corrupt
+// instructions, or of data decoded as code.
+func (i Inst) CrossesBoundary(pc uint64) bool {
+	return i.Len == 8 && pc%64 == 60
+}
+
+// BranchTarget returns the target address of the branch instruction i at address pc.
+// The target of a relative branch is pc plus its offset; that of an absolute
+// branch (AA=1, like ba and bcla) is its sign-extended target_addr field.
+// Whether the branch sets LR (LK=1, like bl) does not change the target.
+// BranchTarget returns ok == false if i is not a branch, or if it branches to
+// an address in LR, CTR or TAR, like blr and bctr, which is not known statically.
+func (i Inst) BranchTarget(pc uint64) (addr uint64, ok bool) {
+	switch i.Op {
+	case B, BA, BL, BLA, BC, BCA, BCL, BCLA:
+	default:
+		return 0, false
+	}
+	for _, a := range i.ActiveArgs() {
+		switch a := a.(type) {
+		case PCRel:
+			return pc + uint64(int64(a)), true
+		case Label:
+			return uint64(int64(int32(a))), true
+		}
+	}
+	return 0, false
+}
+
+// CallTarget returns the name of the function that the call instruction i
+// at address pc calls, for printing a call by name. It returns ok == false
+// if i is not bl or bla, or if symname, which may be nil, does not find
+// a symbol starting at the target: a branch into the middle of a symbol
+// is not a call to it.
+func (i Inst) CallTarget(pc uint64, symname SymLookup) (name string, ok bool) {
+	if i.Op != BL && i.Op != BLA || symname == nil {
+		return "", false
+	}
+	addr, ok := i.BranchTarget(pc)
+	if !ok {
+		return "", false
+	}
+	name, base := symname(addr)
+	if name == "" || base != addr {
+		return "", false
+	}
+	return name, true
+}
+
+// CRUses returns the condition register fields and bits that i reads and
+// writes, for tracking the flow of conditions through code. An argument
+// names either a field, like the BF of cmp, or a bit, like the BI of bc;
+// CondReg.Field relates the two.
+// The record forms (Rc=1, like add. and stwcx.) also write a field that is
+// not an argument: CR0, CR1 for floating-point instructions like fadd.,
+// or CR6 for vector instructions like vcmpequb.
+// A branch whose BO ignores the condition, like bdnz, reads no bit,
+// and mfcr reads and mtcrf writes all the fields that its FXM selects.
+func (i Inst) CRUses() (reads, writes []CondReg) {
+	switch i.Op {
+	case MFCR:
+		return crFields(Imm(0xff)), nil
+	case MFOCRF:
+		return crFields(i.Args[1]), nil
+	case MTCRF, MTOCRF:
+		return nil, crFields(i.Args[0])
+	}
+	fpscr := -1 // an FPSCR field, which is not a CR field
+	switch i.Op {
+	case MTFSFI, MTFSFI_:
+		fpscr = 0
+	case MCRFS:
+		fpscr = 1
+	}
+	ignoresCond := false
+	if bo, ok := i.Args[0].(Imm); ok && i.Op.IsBranch() {
+		ignoresCond = bo&0x10 != 0
+	}
+	for j, arg := range i.ActiveArgs() {
+		c, ok := arg.(CondReg)
+		if !ok || j == fpscr {
+			continue
+		}
+		if j == 0 && i.Op.isDstFirst() {
+			writes = append(writes, c)
+		} else if !ignoresCond {
+			reads = append(reads, c)
+		}
+	}
+	if strings.HasSuffix(i.Op.String(), ".") {
+		switch f := i.Op.flags(); {
+		case f&flagRecordCR1 != 0:
+			writes = append(writes, CR1)
+		case f&flagRecordCR6 != 0:
+			writes = append(writes, CR6)
+		default:
+			writes = append(writes, CR0)
+		}
+	}
+	return reads, writes
+}
+
+// An ArgRole says whether an instruction reads or writes an argument.
+type ArgRole uint8
+
+const (
+	ArgRead      ArgRole = 1 << iota // the argument is an input
+	ArgWrite                         // the argument is a register the instruction writes
+	ArgReadWrite = ArgRead | ArgWrite
+)
+
+// String returns "read", "write" or "read-write".
+func (r ArgRole) String() string {
+	switch r {
+	case ArgRead:
+		return "read"
+	case ArgWrite:
+		return "write"
+	case ArgReadWrite:
+		return "read-write"
+	}
+	return fmt.Sprintf("ArgRole(%d)", int(r))
+}
+
+// ArgRoles returns the role of each of the active arguments of i, for
+// liveness analysis: roles[j] describes i.Args[j]. The destination of
+// add RT,RA,RB is written and its sources read; the insert rotates, like
+// rlwimi, and the VSX multiply-adds, like xsmaddadp, also read their
+// destination; and the loads and stores with update, like lwzu and stwux,
+// read and write the base register RA. Immediates, offsets and branch
+// targets are read. Registers that are not arguments, like the CR0 of
+// a record form and the LR of bl, are not described; see CRUses.
+func (i Inst) ArgRoles() []ArgRole {
+	args := i.ActiveArgs()
+	roles := make([]ArgRole, len(args))
+	for j := range roles {
+		roles[j] = ArgRead
+	}
+	if len(roles) == 0 {
+		return roles
+	}
+	if i.Op.isDstFirst() {
+		roles[0] = ArgWrite
+		if i.Op.flags()&flagDstRead != 0 {
+			roles[0] = ArgReadWrite
+		}
+	}
+	if i.Op.flags()&flagUpdate != 0 {
+		// the base register follows the offset of the D-form, like the
+		// RA of lwzu RT,D(RA), or is the first source of the X-form
+		base := 1
+		for j, arg := range args {
+			if _, ok := arg.(Offset); ok {
+				base = j + 1
+			}
+		}
+		if base < len(roles) {
+			roles[base] = ArgReadWrite
+		}
+	}
+	return roles
+}
+
+// crFields returns the CR fields selected by the FXM mask fxm,
+// in which 0x80 selects CR0 and 0x01 CR7.
+func crFields(fxm Arg) []CondReg {
+	mask, _ := fxm.(Imm)
+	var fields []CondReg
+	for n := 0; n < 8; n++ {
+		if mask&(0x80>>uint(n)) != 0 {
+			fields = append(fields, CR0+CondReg(n))
+		}
+	}
+	return fields
+}
+
+// NumArgs returns the number of arguments of the instruction.
+func (i Inst) NumArgs() int {
+	n := 0
+	for n < len(i.Args) && i.Args[n] != nil {
+		n++
+	}
+	return n
+}
+
+// ActiveArgs returns the arguments of the instruction, without the trailing nil entries of i.Args.
+func (i Inst) ActiveArgs() []Arg {
+	return i.Args[:i.NumArgs()]
+}
+
 // An Op is an instruction operation.
 type Op uint16
 
+// String returns the Power ISA mnemonic of o, as printed by GNUSyntax for
+// instructions without an extended mnemonic: the record form ends in "."
+// and the overflow form in "o", like add, add., addo and addo. for
+// ADD, ADD_, ADDO and ADDO_. Each Op has a distinct mnemonic.
+// An unknown Op prints as Op(n).
 func (o Op) String() string {
-	if int(o) >= len(opstr) || opstr[o] == "" {
+	if !o.known() {
 		return fmt.Sprintf("Op(%d)", int(o))
 	}
 	return opstr[o]
 }
 
-// An Arg is a single instruction argument, one of these types: Reg, CondReg, SpReg, Imm, PCRel, Label, or Offset.
+// known reports whether o is an Op with a mnemonic.
+func (o Op) known() bool {
+	return int(o) < len(opstr) && opstr[o] != ""
+}
+
+// goString returns the name of the Op constant for o, like STWCX_ for
+// stwcx., or Op(n) if o is not a known opcode.
+func (o Op) goString() string {
+	if !o.known() {
+		return o.String()
+	}
+	return strings.ToUpper(strings.Replace(opstr[o], ".", "_", 1))
+}
+
+// Ops returns the known Ops, in increasing order.
+func Ops() []Op {
+	var ops []Op
+	for o := Op(1); int(o) < len(opstr); o++ {
+		if o.known() {
+			ops = append(ops, o)
+		}
+	}
+	return ops
+}
+
+var (
+	opByNameOnce sync.Once
+	opByName     map[string]Op
+)
+
+// LookupOp returns the Op whose String is mnemonic, like ADD_ for "add.".
+// It returns ok == false if no Op has that mnemonic. Extended mnemonics,
+// like mr and blr, are not Ops.
+func LookupOp(mnemonic string) (op Op, ok bool) {
+	opByNameOnce.Do(func() {
+		opByName = make(map[string]Op, len(opstr))
+		for _, o := range Ops() {
+			opByName[opstr[o]] = o
+		}
+	})
+	op, ok = opByName[mnemonic]
+	return op, ok
+}
+
+func (o Op) flags() opFlag {
+	if int(o) >= len(opflags) {
+		return 0
+	}
+	return opflags[o]
+}
+
+// IsBranch reports whether o is a branch instruction, like b, bc, bclr
+// and bcctr, which may transfer control to another instruction.
+// System calls, traps and interrupt returns are not branches.
+func (o Op) IsBranch() bool { return o.flags()&flagBranch != 0 }
+
+// IsLoad reports whether o loads from memory, like lwz, lfd, lvx and lwarx.
+func (o Op) IsLoad() bool { return o.flags()&flagLoad != 0 }
+
+// IsStore reports whether o stores to memory, like stw, stfd, stvx and stwcx.
+// Cache management instructions, such as dcbz, are not stores.
+func (o Op) IsStore() bool { return o.flags()&flagStore != 0 }
+
+// IsPrivileged reports whether o can only be executed in privileged
+// or hypervisor state, like mtmsrd, rfid and tlbie. Whether mtspr and
+// mfspr are privileged depends on the SPR, so they are not.
+func (o Op) IsPrivileged() bool { return o.flags()&flagPrivileged != 0 }
+
+// IsFloatingPoint reports whether o is an instruction of the binary or decimal
+// Floating-Point facilities, like fadd, lfd, mffs and dadd, or an SPE scalar
+// floating-point instruction. Vector and VSX instructions are not.
+func (o Op) IsFloatingPoint() bool { return o.flags()&flagFloatingPoint != 0 }
+
+// isDstFirst reports whether the first argument of o is a register it
+// writes, like the RT of add and the RA of and, as opposed to a source or
+// an address, like the RS of stw and the RA of dcbz.
+func (o Op) isDstFirst() bool { return o.flags()&flagDst != 0 }
+
+// isRAZeroArg reports whether argument i of o is an (RA|0) field, like the
+// RA of lbzx, addi and isel, which reads as 0, not the contents of R0, when it is 0.
+func (o Op) isRAZeroArg(i int) bool {
+	if int(o) >= len(opRAZeroArgs) || i < 0 || i >= 8 {
+		return false
+	}
+	return opRAZeroArgs[o]&(1<<uint(i)) != 0
+}
+
+// isRegPairArg reports whether argument i of o names an even-odd register
+// pair, like the RTp of lq and the FRTp of daddq, which must be even.
+func (o Op) isRegPairArg(i int) bool {
+	if int(o) >= len(opRegPairArgs) || i < 0 || i >= 8 {
+		return false
+	}
+	return opRegPairArgs[o]&(1<<uint(i)) != 0
+}
+
+// An opFlag is a property of an Op, recorded for each Op in opflags.
+type opFlag uint16
+
+const (
+	flagBranch        opFlag = 1 << iota // a branch
+	flagLoad                             // loads from memory
+	flagStore                            // stores to memory
+	flagPrivileged                       // only executes in privileged or hypervisor state
+	flagFloatingPoint                    // a floating-point instruction
+	flagSPE                              // an SPE instruction, decoded only by DecodeSPE
+	flagDst                              // its first operand is a register it writes
+	flagDstRead                          // it also reads the register its first operand writes
+	flagUpdate                           // a load or store with update, which writes the address to RA
+	flagRecordCR1                        // a record form that sets CR1, not CR0
+	flagRecordCR6                        // a record form that sets CR6, not CR0
+)
+
+// An Arg is a single instruction argument, one of these types: Reg, CondReg, SpReg, ACCReg, Imm, PCRel, Label, or Offset.
 type Arg interface {
 	IsArg()
 	String() string
 }
 
 // An Args holds the instruction arguments.
-// If an instruction has fewer than 4 arguments,
+// If an instruction has fewer than 6 arguments,
 // the final elements in the array are nil.
-type Args [5]Arg
+type Args [6]Arg
 
-// A Reg is a single register. The zero value means R0, not the absence of a register.
-// It also includes special registers.
+// A Reg is a single register: a general purpose register R0-R31,
+// a floating-point register F0-F31, a vector register V0-V31 or
+// a vector-scalar register VS0-VS63.
+// The zero Reg is not a register.
+// The constants are part of the API: tools may compare a Reg against
+// them, for example R0 <= r && r <= R31, rather than parsing its name.
 type Reg uint16
 
 const (
@@ -124,7 +573,7 @@ const (
 	F29
 	F30
 	F31
-	V0 // VSX extension, F0 is V0[0:63].
+	V0 // VMX extension, V0 is VS32.
 	V1
 	V2
 	V3
@@ -156,51 +605,121 @@ const (
 	V29
 	V30
 	V31
-	V32
-	V33
-	V34
-	V35
-	V36
-	V37
-	V38
-	V39
-	V40
-	V41
-	V42
-	V43
-	V44
-	V45
-	V46
-	V47
-	V48
-	V49
-	V50
-	V51
-	V52
-	V53
-	V54
-	V55
-	V56
-	V57
-	V58
-	V59
-	V60
-	V61
-	V62
-	V63
+	VS0 // VSX extension, F0 is VS0[0:63].
+	VS1
+	VS2
+	VS3
+	VS4
+	VS5
+	VS6
+	VS7
+	VS8
+	VS9
+	VS10
+	VS11
+	VS12
+	VS13
+	VS14
+	VS15
+	VS16
+	VS17
+	VS18
+	VS19
+	VS20
+	VS21
+	VS22
+	VS23
+	VS24
+	VS25
+	VS26
+	VS27
+	VS28
+	VS29
+	VS30
+	VS31
+	VS32
+	VS33
+	VS34
+	VS35
+	VS36
+	VS37
+	VS38
+	VS39
+	VS40
+	VS41
+	VS42
+	VS43
+	VS44
+	VS45
+	VS46
+	VS47
+	VS48
+	VS49
+	VS50
+	VS51
+	VS52
+	VS53
+	VS54
+	VS55
+	VS56
+	VS57
+	VS58
+	VS59
+	VS60
+	VS61
+	VS62
+	VS63
 )
 
 func (Reg) IsArg() {}
+
+// String returns the name of the register, R0-R31, F0-F31, V0-V31 or VS0-VS63.
 func (r Reg) String() string {
+	if int(r) < len(regNames) {
+		return regNames[r]
+	}
+	return r.name()
+}
+
+// regNames holds the name of each register, so that formatting an
+// instruction does not allocate them.
+var regNames = func() (names [VS63 + 1]string) {
+	for r := range names {
+		names[r] = Reg(r).name()
+	}
+	return names
+}()
+
+// name computes r.String().
+func (r Reg) name() string {
+	switch {
+	case R0 <= r && r <= R31:
+		return fmt.Sprintf("R%d", int(r-R0))
+	case F0 <= r && r <= F31:
+		return fmt.Sprintf("F%d", int(r-F0))
+	case V0 <= r && r <= V31:
+		return fmt.Sprintf("V%d", int(r-V0))
+	case VS0 <= r && r <= VS63:
+		return fmt.Sprintf("VS%d", int(r-VS0))
+	default:
+		return fmt.Sprintf("Reg(%d)", int(r))
+	}
+}
+
+// Number returns the number of r within its register file,
+// for example 3 for R3, F3, V3 and VS3, or -1 if r is not a register.
+func (r Reg) Number() int {
 	switch {
 	case R0 <= r && r <= R31:
-		return fmt.Sprintf("r%d", int(r-R0))
+		return int(r - R0)
 	case F0 <= r && r <= F31:
-		return fmt.Sprintf("f%d", int(r-F0))
-	case V0 <= r && r <= V63:
-		return fmt.Sprintf("v%d", int(r-V0))
+		return int(r - F0)
+	case V0 <= r && r <= V31:
+		return int(r - V0)
+	case VS0 <= r && r <= VS63:
+		return int(r - VS0)
 	default:
-		return fmt.Sprint("Reg(%d)", int(r))
+		return -1
 	}
 }
 
@@ -254,17 +773,46 @@ const (
 )
 
 func (CondReg) IsArg() {}
+
+// String returns the name of c, CR0-CR7 for a field and Cond0LT-Cond7SO
+// for a bit, or CondReg(n) if c is neither.
 func (c CondReg) String() string {
-	switch {
-	default:
+	switch f, b := c.Field(), c.Bit(); {
+	case f < 0:
 		return fmt.Sprintf("CondReg(%d)", int(c))
-	case c >= CR0:
-		return fmt.Sprintf("CR%d", int(c-CR0))
-	case c >= Cond0LT && c < CR0:
-		return fmt.Sprintf("Cond%d%s", int((c-Cond0LT)/4), [4]string{"LT", "GT", "EQ", "SO"}[(c-Cond0LT)%4])
+	case b < 0:
+		return fmt.Sprintf("CR%d", f)
+	default:
+		return fmt.Sprintf("Cond%d%s", f, condBitNames[b])
+	}
+}
+
+// Field returns the number of the CR field that c is or holds a bit of,
+// for example 0 for CR0 and Cond0EQ and 7 for CR7 and Cond7SO,
+// or -1 if c is not a CR field or bit.
+func (c CondReg) Field() int {
+	switch {
+	case Cond0LT <= c && c <= Cond7SO:
+		return int(c-Cond0LT) / 4
+	case CR0 <= c && c <= CR7:
+		return int(c - CR0)
 	}
+	return -1
 }
 
+// Bit returns the position of the bit c in its CR field, from 0 for the LT
+// bit to 3 for the SO bit, as indexed by condBitNames. It returns -1 if c
+// is a CR field or is not a CR bit.
+func (c CondReg) Bit() int {
+	if Cond0LT <= c && c <= Cond7SO {
+		return int(c-Cond0LT) % 4
+	}
+	return -1
+}
+
+// condBitNames names the bits of a CR field, by CondReg.Bit.
+var condBitNames = [4]string{"LT", "GT", "EQ", "SO"}
+
 // SpReg is a special register, its meaning depends on Op.
 type SpReg uint16
 
@@ -277,12 +825,40 @@ func (s SpReg) String() string {
 	return fmt.Sprintf("SpReg(%d)", int(s))
 }
 
-// PCRel is a PC-relative offset, used only in branch instructions.
-type PCRel int32
+// An ACCReg is one of the accumulators ACC0-ACC7 of the Power10
+// Matrix-Multiply Assist (MMA) facility, like the AT of xvf32ger.
+// Accumulator n is associated with the VSX registers VS4n to VS4n+3,
+// which xxmfacc and xxmtacc copy it from and to.
+type ACCReg uint8
+
+const (
+	ACC0 ACCReg = iota
+	ACC1
+	ACC2
+	ACC3
+	ACC4
+	ACC5
+	ACC6
+	ACC7
+)
+
+func (ACCReg) IsArg() {}
+
+// String returns the name of a, ACC0-ACC7, or ACCReg(n) if a is not an accumulator.
+func (a ACCReg) String() string {
+	if a > ACC7 {
+		return fmt.Sprintf("ACCReg(%d)", int(a))
+	}
+	return fmt.Sprintf("ACC%d", int(a))
+}
+
+// PCRel is a PC-relative offset, used in branch instructions and
+// PC-relative prefixed instructions.
+type PCRel int64
 
 func (PCRel) IsArg() {}
 func (r PCRel) String() string {
-	return fmt.Sprintf("PC%+#x", int32(r))
+	return fmt.Sprintf("PC%+#x", int64(r))
 }
 
 // A Label is a code (text) address, used only in absolute branch instructions.
@@ -294,17 +870,17 @@ func (l Label) String() string {
 }
 
 // Imm represents an immediate number.
-type Imm int32
+type Imm int64
 
 func (Imm) IsArg() {}
 func (i Imm) String() string {
-	return fmt.Sprintf("%d", int32(i))
+	return fmt.Sprintf("%d", int64(i))
 }
 
 // Offset represents a memory offset immediate.
-type Offset int32
+type Offset int64
 
 func (Offset) IsArg() {}
 func (o Offset) String() string {
-	return fmt.Sprintf("%+d", int32(o))
+	return fmt.Sprintf("%+d", int64(o))
 }
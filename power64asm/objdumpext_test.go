@@ -56,7 +56,7 @@ func objdump(ext *ExtDis) error {
 		if addr == next {
 			if m := pcrel.FindStringSubmatch(text); m != nil {
 				targ, _ := strconv.ParseUint(m[2], 16, 64)
-				text = fmt.Sprintf("%s.%+#x", m[1], int32(uint32(targ)-addr))
+				text = fmt.Sprintf("%s%#x", m[1], targ)
 			}
 			if strings.HasPrefix(text, "stmia") {
 				text = "stm" + text[5:]
@@ -6,159 +6,899 @@ package power64asm
 
 import (
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"sync"
 )
 
-// plan9Syntax returns the Go assembler syntax for the instruction.
+// A Mode selects variations of the Go assembler syntax printed by Plan9SyntaxMode.
+// Modes may be combined with |.
+type Mode uint
+
+const (
+	// ModeISAOrder prints operands in Power ISA manual order, as GNUSyntax
+	// does, instead of moving the destination to the end, and names the
+	// instructions as the manual does, in upper case, like LD R3, 8(R4):
+	// a Go mnemonic with ISA operand order would read as another instruction.
+	ModeISAOrder Mode = 1 << iota
+
+	// ModeGAlias prints R30 as g, the name the Go toolchain gives to the
+	// register holding the current goroutine. Code not compiled by Go uses
+	// R30 as an ordinary register, so it is printed as R30 by default.
+	ModeGAlias
+
+	// ModeHexImm prints immediates in hexadecimal, like $0x1234, unless they
+	// are small enough to be shift counts or element indexes (less than 64 in
+	// magnitude). The masks of the logical immediate instructions, such as
+	// andi. and ori, and the bit patterns splatted by xxspltiw and xxspltidp
+	// are printed in hexadecimal in every mode.
+	ModeHexImm
+
+	// ModeTOC annotates the instructions that address memory relative to
+	// R2, which holds the TOC pointer in the Power ELF ABI, and the addi and
+	// addis that add to it, with a comment giving the address as an offset
+	// from the TOC, like MOVD 24(R2), R3 // TOC+24. A TOC entry is then
+	// found at the same offset from the .TOC. symbol of the object file.
+	ModeTOC
+
+	// ModeISANames prints the mnemonic of each instruction as the Power ISA
+	// manual names it, in upper case, like LBZ, B and ADD. instead of the Go
+	// assembler's MOVBZ, BR and ADDCC, with the operands laid out as usual.
+	// Extended mnemonics, such as RET for bclr and MOVD for mr, are not used,
+	// so every operand of the instruction is printed as encoded.
+	ModeISANames
+)
+
+// A SymLookup queries the symbol table for the program being
+// disassembled. It returns the name and base address of the symbol
+// containing addr, if any; otherwise it returns "", 0.
+type SymLookup func(addr uint64) (name string, base uint64)
+
+// A SymSizeLookup is like a SymLookup, but it also returns the size of the
+// symbol, for symbol tables that find the symbol starting at or before
+// an address, which may not contain it.
+type SymSizeLookup func(addr uint64) (name string, base, size uint64)
+
+// SymLookup returns a SymLookup that returns the symbol found by f
+// only if it contains addr, that is, if base <= addr < base+size.
+// A symbol of size 0 contains only its base address.
+func (f SymSizeLookup) SymLookup() SymLookup {
+	return func(addr uint64) (string, uint64) {
+		name, base, size := f(addr)
+		if name == "" || addr < base || addr != base && addr-base >= size {
+			return "", 0
+		}
+		return name, base
+	}
+}
+
+// Plan9Syntax returns the Go assembler syntax for the instruction.
 // The syntax was originally defined by Plan 9.
+// R30 is printed as g, as in Go-compiled code; use Plan9SyntaxMode
+// without ModeGAlias to disassemble other code.
 // The pc is the program counter of the first instruction, used for expanding
 // PC-relative addresses into absolute ones.
-// The symname function queries the symbol table for the program
-// being disassembled. It returns the name and base address of the symbol
-// containing the target, if any; otherwise it returns "", 0.
-func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) string {
+// The symname function, which may be nil, names the addresses of branch
+// targets and data: an address inside a symbol prints as sym+off(SB),
+// and a bl or bla to the start of a symbol prints as CALL sym(SB).
+// An Inst with Op 0, like one that failed to decode, prints as "?", and
+// one with an Op that has no mnemonic as "? (op=N)".
+func Plan9Syntax(inst Inst, pc uint64, symname SymLookup) string {
+	return Plan9SyntaxMode(inst, pc, symname, ModeGAlias)
+}
+
+// DataWord returns the Go assembler directive for the word enc, such as
+// WORD $0x00000000, for printing an instruction that does not decode.
+// The word assembles back to enc in the byte order of the target.
+func DataWord(enc uint32) string {
+	var buf [len("WORD $0x00000000")]byte
+	return string(appendDataWord(buf[:0], enc))
+}
+
+// appendDataWord appends DataWord(enc) to dst.
+func appendDataWord(dst []byte, enc uint32) []byte {
+	dst = append(dst, "WORD $0x"...)
+	for shift := 28; shift >= 0; shift -= 4 {
+		dst = append(dst, hexDigits[enc>>uint(shift)&0xf])
+	}
+	return dst
+}
+
+// Plan9SyntaxMode is like Plan9Syntax but prints the instruction
+// with the variations selected by mode.
+func Plan9SyntaxMode(inst Inst, pc uint64, symname SymLookup, mode Mode) string {
+	bp := plan9BufPool.Get().(*[]byte)
+	*bp = appendPlan9((*bp)[:0], inst, pc, symname, mode)
+	s := string(*bp)
+	plan9BufPool.Put(bp)
+	return s
+}
+
+// AppendPlan9 appends the Go assembler syntax for the instruction,
+// as printed by Plan9Syntax with the same pc and symname, to dst and
+// returns the extended buffer. A disassembler that reuses the buffer
+// for every instruction formats them without allocating.
+func (i Inst) AppendPlan9(dst []byte, pc uint64, symname SymLookup) []byte {
+	return appendPlan9(dst, i, pc, symname, ModeGAlias)
+}
+
+// WritePlan9 writes the Go assembler syntax for the instruction to w,
+// as printed by Plan9Syntax with the same pc and symname.
+// It formats the text in a buffer shared by its calls instead of
+// building a string, so a disassembler printing many instructions to
+// a buffered writer allocates nothing for them.
+func (i Inst) WritePlan9(w io.Writer, pc uint64, symname SymLookup) error {
+	bp := plan9BufPool.Get().(*[]byte)
+	*bp = i.AppendPlan9((*bp)[:0], pc, symname)
+	_, err := w.Write(*bp)
+	plan9BufPool.Put(bp)
+	return err
+}
+
+// plan9BufPool holds the buffers that Plan9SyntaxMode and WritePlan9
+// format instructions in.
+var plan9BufPool = sync.Pool{New: func() interface{} { return new([]byte) }}
+
+// appendPlan9 appends the Go assembler syntax for inst to dst.
+func appendPlan9(dst []byte, inst Inst, pc uint64, symname SymLookup, mode Mode) []byte {
+	// The mnemonic and operands are formatted past the end of dst,
+	// then laid out after them and moved down into place.
+	start := len(dst)
+	t := plan9Text{buf: dst}
+	// room to rotate the destination operand to the end in place
+	var argbuf [len(Args{}) + 1]span
+	op, args := plan9Syntax(&t, inst, pc, symname, mode, argbuf[:0])
+	out := len(t.buf)
+	t.buf = append(t.buf, t.text(op)...)
+	for i, arg := range args {
+		if i == 0 {
+			t.buf = append(t.buf, ' ')
+		} else {
+			t.buf = append(t.buf, ", "...)
+		}
+		t.buf = append(t.buf, t.text(arg)...)
+	}
+	if off, ok := tocOffset(inst); ok && mode&ModeTOC != 0 {
+		t.buf = append(t.buf, " // TOC"...)
+		if off > 0 {
+			t.buf = append(t.buf, '+')
+		}
+		if off != 0 {
+			t.buf = strconv.AppendInt(t.buf, off, 10)
+		}
+	}
+	n := copy(t.buf[start:], t.buf[out:])
+	return t.buf[:start+n]
+}
+
+// tocOffset returns the offset from R2 of the address that inst loads
+// from, stores to or computes, if inst is a memory access with base
+// register R2 or adds an immediate to R2.
+func tocOffset(inst Inst) (off int64, ok bool) {
+	switch inst.Op {
+	case ADDI, ADDIS:
+		if inst.Args[1] != R2 {
+			return 0, false
+		}
+		off = int64(inst.Args[2].(Imm))
+		if inst.Op == ADDIS {
+			off <<= 16
+		}
+		return off, true
+	}
+	for i, arg := range inst.Args {
+		if o, isOff := arg.(Offset); isOff && i+1 < len(inst.Args) && inst.Args[i+1] == R2 {
+			return int64(o), true
+		}
+	}
+	return 0, false
+}
+
+// A plan9Text holds the text of an instruction as it is formatted.
+// The mnemonic and each operand are spans of buf, so that operands can be
+// dropped, reordered and combined without building a string for each.
+type plan9Text struct {
+	buf []byte
+}
+
+// A span is the text buf[lo:hi] of a plan9Text. An empty span is an
+// operand that is not printed.
+type span struct{ lo, hi int }
+
+// from returns the span of the text appended to t.buf since it had length lo.
+func (t *plan9Text) from(lo int) span { return span{lo, len(t.buf)} }
+
+// text returns the text of s.
+func (t *plan9Text) text(s span) []byte { return t.buf[s.lo:s.hi] }
+
+// str appends s to t and returns its span.
+func (t *plan9Text) str(s string) span {
+	lo := len(t.buf)
+	t.buf = append(t.buf, s...)
+	return t.from(lo)
+}
+
+// upper appends s in upper case to t and returns its span.
+func (t *plan9Text) upper(s string) span {
+	lo := len(t.buf)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		t.buf = append(t.buf, c)
+	}
+	return t.from(lo)
+}
+
+// imm appends the immediate $n to t and returns its span.
+func (t *plan9Text) imm(n int64) span {
+	lo := len(t.buf)
+	t.buf = strconv.AppendInt(append(t.buf, '$'), n, 10)
+	return t.from(lo)
+}
+
+// hexImm appends the immediate n in hexadecimal, like $0x1f, to t and returns its span.
+func (t *plan9Text) hexImm(n int64) span {
+	lo := len(t.buf)
+	t.buf = append(t.buf, '$')
+	u := uint64(n)
+	if n < 0 {
+		t.buf = append(t.buf, '-')
+		u = -u
+	}
+	t.buf = strconv.AppendUint(append(t.buf, "0x"...), u, 16)
+	return t.from(lo)
+}
+
+// wrap appends the text of s between before and after to t and returns its span.
+func (t *plan9Text) wrap(before string, s span, after string) span {
+	lo := len(t.buf)
+	t.buf = append(t.buf, before...)
+	t.buf = append(t.buf, t.text(s)...)
+	t.buf = append(t.buf, after...)
+	return t.from(lo)
+}
+
+// plan9Syntax formats the Go assembler mnemonic and operands of inst into t,
+// appending the spans of the operands to args. Instructions printed with
+// a special form may return the whole text as op, with no operands.
+func plan9Syntax(t *plan9Text, inst Inst, pc uint64, symname SymLookup, mode Mode, args []span) (op span, operands []span) {
 	if symname == nil {
-		symname = func(uint64) (string, uint64) { return "", 0 }
+		symname = noSymbols
 	}
 	if inst.Op == 0 {
-		return "?"
+		return t.str("?"), nil
 	}
-	var args []string
-	for i, a := range inst.Args[:] {
-		if a == nil {
-			break
-		}
-		if s := plan9Arg(&inst, i, pc, a, symname); s != "" {
+	if !inst.Op.known() {
+		// not returned by Decode, but the tables cannot format it
+		lo := len(t.buf)
+		t.buf = strconv.AppendInt(append(t.buf, "? (op="...), int64(inst.Op), 10)
+		t.buf = append(t.buf, ')')
+		return t.from(lo), nil
+	}
+	// plan9Arg may remove arguments it has folded into a memory operand,
+	// so recount them on every iteration.
+	for i := 0; i < inst.NumArgs(); i++ {
+		if s := plan9Arg(t, &inst, i, pc, inst.Args[i], symname, mode); s.lo != s.hi {
 			args = append(args, s)
 		}
 	}
-	var op string
-	op = plan9OpMap[inst.Op]
-	if op == "" {
-		op = strings.ToUpper(inst.Op.String())
+	if mode&ModeISANames != 0 {
+		return t.upper(inst.Op.String()), plan9Operands(t, inst, args, mode)
+	}
+	// instructions printed with extended mnemonics
+	if name := nopName(inst); name != "" {
+		return t.upper(name), nil
+	}
+	if name := syncName(inst); name != "" {
+		return t.upper(name), nil
 	}
-	// laid out the instruction
 	switch inst.Op {
-	default: // dst, sA, sB, ...
-		if len(args) == 0 {
-			return op
-		} else if len(args) == 1 {
-			return fmt.Sprintf("%s %s", op, args[0])
-		}
-		args = append(args, args[0])
-		return op + " " + strings.Join(args[1:], ", ")
-	// store instructions always have the memory operand at the end, no need to reorder
-	case STB, STBU, STBX, STBUX,
-		STH, STHU, STHX, STHUX,
-		STW, STWU, STWX, STWUX,
-		STD, STDU, STDX, STDUX,
-		STQ,
-		STHBRX, STWBRX:
-		return op + " " + strings.Join(args, ", ")
-	// branch instructions needs additional handling
 	case BCLR:
 		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
-			return "RET"
+			return t.str("RET"), nil
 		}
-		return op + " " + strings.Join(args, ", ")
 	case BC:
-		if int(inst.Args[0].(Imm))&0x1c == 12 { // jump on cond bit set
-			return fmt.Sprintf("B%s %s", args[1], args[2])
-		} else if int(inst.Args[0].(Imm))&0x1c == 4 && revCondMap[args[1]] != "" { // jump on cond bit not set
-			return fmt.Sprintf("B%s %s", revCondMap[args[1]], args[2])
+		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
+			return t.str("BR"), args[2:3]
+		}
+		if op, operands, ok := plan9CondBranch(t, inst, args); ok {
+			return op, operands
+		}
+	case BL, BLA:
+		// a call to the start of a function names it, like the Go assembler,
+		// whether the target is relative or absolute
+		if name, ok := inst.CallTarget(pc, symname); ok {
+			lo := len(t.buf)
+			t.buf = append(append(t.buf, name...), "(SB)"...)
+			sym := t.from(lo)
+			return t.str("CALL"), append(args[:0], sym)
+		}
+	case BCL:
+		// bcl 20,31,$+4 followed by mflr reads the PC in PIC prologues
+		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
+			return t.str("BL"), args[2:3]
 		}
 	case BCCTR:
 		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
-			return "BR (CTR)"
+			return t.str("BR (CTR)"), nil
 		}
-		return op + " " + strings.Join(args, ", ")
 	case BCCTRL:
 		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
-			return "BL (CTR)"
+			return t.str("BL (CTR)"), nil
+		}
+	case BCTAR:
+		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
+			return t.str("BR (TAR)"), nil
+		}
+	case BCTARL:
+		if int(inst.Args[0].(Imm))&20 == 20 { // unconditional
+			return t.str("BL (TAR)"), nil
+		}
+	// rotates that correspond to shifts or masks use those mnemonics
+	case RLWINM, RLDICL, RLDICR, RLDIC:
+		if mode&ModeISAOrder == 0 {
+			if op, operands, ok := plan9Rotate(t, inst, args); ok {
+				return op, operands
+			}
+		}
+	case RLDCL:
+		if mode&ModeISAOrder == 0 && inst.Args[3] == Imm(0) { // rotld
+			return t.str("ROTL"), append(args[:0], args[2], args[1], args[0])
+		}
+	case OR, NOR:
+		if inst.Args[1] == inst.Args[2] && mode&ModeISAOrder == 0 { // mr, not
+			if inst.Op == NOR {
+				return t.str("NOT"), append(args[:0], args[1], args[0])
+			}
+			return t.str("MOVD"), append(args[:0], args[1], args[0])
+		}
+	case TW, TD, TWI, TDI:
+		if name := trapName(inst); name == "trap" {
+			return t.str("TRAP"), nil
+		} else if name != "" {
+			return t.upper(name), args[1:3]
 		}
-		return op + " " + strings.Join(args, ", ")
-	case BCA, BCL, BCLA, BCLRL, BCTAR, BCTARL:
-		return op + " " + strings.Join(args, ", ")
+	// condition register moves
+	case MFCR:
+		if mode&ModeISAOrder == 0 {
+			return t.str("MOVW"), append(args[:0], t.str("CR"), args[0])
+		}
+	case MTCRF:
+		if mode&ModeISAOrder == 0 {
+			return t.str("MOVFL"), append(args[:0], args[1], t.hexImm(int64(inst.Args[0].(Imm))))
+		}
+	case PADDI:
+		// paddi with R set computes the address SI bytes from the instruction
+		if isPCRelPrefixed(&inst) && inst.Args[1] == R0 {
+			lo := len(t.buf)
+			t.buf = appendPlan9Addr(append(t.buf, '$'), pc+uint64(int64(inst.Args[2].(Imm))), symname)
+			addr := t.from(lo)
+			op = plan9Mnemonic(t, inst.Op, mode)
+			if mode&ModeISAOrder != 0 {
+				return op, append(args[:0], args[0], addr)
+			}
+			return op, append(args[:0], addr, args[0])
+		}
+	case MTOCRF:
+		// the Go assembler uses mtocrf for a single CR field
+		if fxm := int(inst.Args[0].(Imm)); mode&ModeISAOrder == 0 && fxm != 0 && fxm&(fxm-1) == 0 {
+			n := 7
+			for fxm > 1 {
+				fxm >>= 1
+				n--
+			}
+			return t.str("MOVFL"), append(args[:0], args[1], t.str(crFieldNames[n]))
+		}
+	}
+	return plan9Mnemonic(t, inst.Op, mode), plan9Operands(t, inst, args, mode)
+}
+
+// plan9Mnemonic formats the mnemonic of op into t: the Go assembler's,
+// or the ISA manual's in upper case under ModeISANames and ModeISAOrder.
+func plan9Mnemonic(t *plan9Text, op Op, mode Mode) span {
+	if mode&(ModeISANames|ModeISAOrder) != 0 {
+		return t.upper(op.String())
 	}
-	panic("unreachable")
+	return t.str(plan9OpName(op))
 }
 
-// plan9Arg formats arg (which is the argIndex's arg in inst) according to Plan 9 rules.
-// NOTE: because Plan9Syntax is the only caller of this func, and it receives a copy
-//       of inst, it's ok to modify inst.Args here.
-func plan9Arg(inst *Inst, argIndex int, pc uint64, arg Arg, symname func(uint64) (string, uint64)) string {
-	// special cases for load/store instructions
-	if _, ok := arg.(Offset); ok {
-		if argIndex+1 == len(inst.Args) || inst.Args[argIndex+1] == nil {
-			panic(fmt.Errorf("wrong table: offset not followed by register"))
+// crFieldNames names the CR fields, by number.
+var crFieldNames = [8]string{"CR0", "CR1", "CR2", "CR3", "CR4", "CR5", "CR6", "CR7"}
+
+// plan9Operands lays out the formatted arguments args of inst as the
+// operands of the instruction, in the order selected by mode.
+func plan9Operands(t *plan9Text, inst Inst, args []span, mode Mode) []span {
+	// indexed loads and stores use the (RA)(RB) memory operand
+	switch inst.Op {
+	case LBZX, LBZUX, LHZX, LHZUX, LHAX, LHAUX,
+		LWZX, LWZUX, LWAX, LWAUX, LDX, LDUX,
+		LHBRX, LWBRX, LDBRX, LSWX,
+		LBARX, LHARX, LWARX, LDARX, LQARX,
+		LFSX, LFSUX, LFDX, LFDUX, LFIWAX, LFIWZX,
+		LVX, LVXL, LVEBX, LVEHX, LVEWX, LVSL, LVSR,
+		LXSDX, LXSIWAX, LXSIWZX, LXSSPX, LXVD2X, LXVDSX, LXVW4X, LXVPX,
+		STBX, STBUX, STHX, STHUX, STWX, STWUX, STDX, STDUX,
+		STHBRX, STWBRX, STDBRX, STSWX,
+		STBCX_, STHCX_, STWCX_, STDCX_, STQCX_,
+		STFSX, STFSUX, STFDX, STFDUX, STFIWX,
+		STVX, STVXL, STVEBX, STVEHX, STVEWX,
+		STXSDX, STXSIWX, STXSSPX, STXVD2X, STXVW4X, STXVPX,
+		EVLDDX, EVLDHX, EVLDWX, EVLHHESPLATX, EVLHHOSSPLATX, EVLHHOUSPLATX,
+		EVLWHEX, EVLWHOSX, EVLWHOUX, EVLWHSPLATX, EVLWWSPLATX,
+		EVSTDDX, EVSTDHX, EVSTDWX, EVSTWHEX, EVSTWHOX, EVSTWWEX, EVSTWWOX:
+		args = append(append(args[:1], plan9Indexed(t, inst, args, 1)), args[3:]...)
+	// cache management, copy and paste take the memory operand first
+	case DCBT, DCBTST, DCBF, DCBZ, DCBST, DCBI, DCBA, ICBI, COPY, PASTE_:
+		args = append(append(args[:0], plan9Indexed(t, inst, args, 0)), args[2:]...)
+	// the string immediate forms address (RA) and take the byte count NB
+	case LSWI, STSWI:
+		args = append(args[:1], t.wrap("(", args[1], ")"), args[2])
+	}
+	if mode&ModeISAOrder != 0 || len(args) < 2 {
+		return args
+	}
+	switch inst.Op {
+	default:
+		// dst, sA, sB, ... becomes sA, sB, ..., dst; instructions whose first
+		// operand is not a destination, like stores, branches, traps, cache
+		// management and TLB invalidations, keep their operand order
+		if !inst.Op.isDstFirst() {
+			return args
+		}
+		return append(args[1:], args[0])
+	// vector splats take the element index first, like the Go assembler,
+	// and the element extracts and inserts their byte index
+	case VSPLTB, VSPLTH, VSPLTW,
+		VEXTRACTUB, VEXTRACTUH, VEXTRACTUW, VEXTRACTD,
+		VINSERTB, VINSERTH, VINSERTW, VINSERTD:
+		return append(args[:0], args[2], args[1], args[0])
+	// compares put the CR field last, if it is not the default CR0
+	case CMPW, CMPD, CMPWI, CMPDI, CMPLW, CMPLD, CMPLWI, CMPLDI, FCMPU, FCMPO, DCMPU, DCMPO, DCMPUQ, DCMPOQ:
+		if inst.Args[0] == CR0 {
+			return args
 		}
+		return append(args[1:], args[0])
+	case STSWI: // RS, $NB, (RA)
+		return append(args[:0], args[0], args[2], args[1])
+	case ADDI, ADDIS: // SI, RA, RT
+		return append(args[:0], args[2], args[1], args[0])
+	// 64-bit rotates take the shift first, like the Go assembler:
+	// RLDICL $sh, RS, $mb, RA and RLDCL RB, RS, $mb, RA
+	case RLDICL, RLDICL_, RLDICR, RLDICR_, RLDIC, RLDIC_, RLDIMI, RLDIMI_, RLDCL, RLDCL_, RLDCR, RLDCR_:
+		return append(args[:0], args[2], args[1], args[3], args[0])
+	// fused multiply-adds compute FRT = FRA*FRC + FRB, but the Go
+	// assembler takes FRA, FRB, FRC, FRT like the other A-form instructions
+	case FMADD, FMADD_, FMADDS, FMADDS_, FMSUB, FMSUB_, FMSUBS, FMSUBS_,
+		FNMADD, FNMADD_, FNMADDS, FNMADDS_, FNMSUB, FNMSUB_, FNMSUBS, FNMSUBS_,
+		FSEL, FSEL_:
+		return append(args[:0], args[1], args[3], args[2], args[0])
+	case MTFSF, MTFSF_: // FRB, FLM as a mask, followed by L and W if they are set
+		args = append(args[:0], args[1], t.hexImm(int64(inst.Args[0].(Imm))), args[2], args[3])
+		if inst.Args[2] == Imm(0) && inst.Args[3] == Imm(0) {
+			args = args[:2]
+		}
+		return args
+	case ISEL: // BC, RA, RB, RT
+		return append(args[:0], args[3], args[1], args[2], args[0])
+	case PADDI: // SI, RA, RT, followed by R if it is set
+		return append(append(args[:0], args[2], args[1], args[0]), args[3:]...)
 	}
+}
+
+// plan9Arg formats arg (which is the argIndex's arg in inst) into t according to
+// Plan 9 rules, returning its span, which is empty if arg is not printed.
+// NOTE: because plan9Syntax is the only caller of this func, and it receives a copy
+// of inst, it's ok to modify inst.Args here.
+func plan9Arg(t *plan9Text, inst *Inst, argIndex int, pc uint64, arg Arg, symname SymLookup, mode Mode) span {
+	lo := len(t.buf)
 	switch arg := arg.(type) {
 	case Reg:
-		if isLoadStoreOp(inst.Op) && argIndex == 1 && arg == R0 {
-			return "0"
-		}
-		if arg == R30 {
-			return "g"
+		if arg == R0 && inst.Op.isRAZeroArg(argIndex) {
+			return t.str("0")
 		}
-		return strings.ToUpper(arg.String())
+		return t.str(plan9Reg(arg, mode))
+	case ACCReg:
+		t.buf = strconv.AppendInt(append(t.buf, 'A'), int64(arg-ACC0), 10) // the Go assembler's name
+		return t.from(lo)
 	case CondReg:
-		if arg == CR0 && strings.HasPrefix(inst.Op.String(), "cmp") {
-			return "" // don't show cr0 for cmp instructions
-		} else if arg >= CR0 {
-			return fmt.Sprintf("CR%d", int(arg-CR0))
+		if arg == CR0 && (isCompareOp(inst.Op) || isFloatCompareOp(inst.Op)) && argIndex == 0 {
+			return t.from(lo) // don't show cr0 for cmp instructions
+		} else if arg.Bit() < 0 {
+			if f := arg.Field(); f >= 0 {
+				return t.str(crFieldNames[f])
+			}
+			return t.str(arg.String())
 		}
-		bit := [4]string{"LT", "GT", "EQ", "SO"}[(arg-Cond0LT)%4]
-		if arg <= Cond0SO {
-			return bit
+		bit := condBitNames[arg.Bit()]
+		if arg.Field() == 0 && !isCRBitOp(inst.Op) {
+			return t.str(bit) // a branch condition in CR0
 		}
-		return fmt.Sprintf("4*CR%d+%s", int(arg-Cond0LT)/4, bit)
+		t.buf = append(append(append(t.buf, "4*"...), crFieldNames[arg.Field()]...), '+')
+		t.buf = append(t.buf, bit...)
+		return t.from(lo)
 	case Imm:
-		return fmt.Sprintf("$%d", arg)
+		if arg == 0 && hasPrefixedR(inst.Op) && isLastArg(inst, argIndex) {
+			return t.from(lo) // R=0 is implied
+		}
+		if hasOptionalImm(inst.Op) && isZeroTail(inst, argIndex) {
+			return t.from(lo)
+		}
+		if inst.Op == LIS && mode&(ModeISANames|ModeISAOrder) == 0 {
+			arg <<= 16 // print the value loaded
+		}
+		if inst.Op == ADDPCIS { // print the address computed, NIA + D<<16
+			t.buf = appendPlan9Addr(append(t.buf, '$'), pc+4+uint64(int64(arg)<<16), symname)
+			return t.from(lo)
+		}
+		if isBitPatternImmOp(inst.Op) && arg != 0 || mode&ModeHexImm != 0 && (arg >= 64 || arg <= -64) {
+			return t.hexImm(int64(arg))
+		}
+		return t.imm(int64(arg))
 	case SpReg:
-		switch arg {
-		case 8:
-			return "LR"
-		case 9:
-			return "CTR"
+		if name := plan9SpRegNames[arg]; name != "" && (inst.Op == MFSPR || inst.Op == MTSPR) {
+			return t.str(name)
 		}
-		return fmt.Sprintf("SPR(%d)", int(arg))
+		t.buf = strconv.AppendInt(append(t.buf, "SPR("...), int64(arg), 10)
+		t.buf = append(t.buf, ')')
+		return t.from(lo)
 	case PCRel:
-		addr := pc + uint64(int64(arg))
-		if s, base := symname(addr); s != "" && base == addr {
-			return fmt.Sprintf("%s(SB)", s)
-		}
-		return fmt.Sprintf("%#x", addr)
+		t.buf = appendPlan9Addr(t.buf, pc+uint64(int64(arg)), symname)
+		return t.from(lo)
 	case Label:
-		return fmt.Sprintf("%#x", int(arg))
+		// the target of an absolute branch does not depend on pc:
+		// the field is sign-extended, so ba -0x100 reaches the top of memory
+		t.buf = appendPlan9Addr(t.buf, uint64(int64(int32(arg))), symname)
+		return t.from(lo)
 	case Offset:
-		reg := inst.Args[argIndex+1].(Reg)
+		// Decode ensures an offset is followed by its base register
+		var reg Reg
+		if argIndex+1 < len(inst.Args) {
+			reg, _ = inst.Args[argIndex+1].(Reg)
+		}
+		if reg == 0 {
+			break
+		}
+		if reg == R0 && isPCRelPrefixed(inst) {
+			// the address is relative to the instruction, like a branch target
+			removeArg(inst, argIndex+1)
+			removeArg(inst, argIndex+1)
+			t.buf = appendPlan9Addr(t.buf, pc+uint64(int64(arg)), symname)
+			return t.from(lo)
+		}
 		removeArg(inst, argIndex+1)
+		t.buf = strconv.AppendInt(t.buf, int64(arg), 10)
 		if reg == R0 {
-			return fmt.Sprintf("%d(0)", int(arg))
+			t.buf = append(t.buf, "(0)"...)
+		} else {
+			t.buf = append(append(append(t.buf, '('), plan9Reg(reg, mode)...), ')')
 		}
-		return fmt.Sprintf("%d(R%d)", int(arg), reg-R0)
+		return t.from(lo)
 	}
-	return fmt.Sprintf("???(%v)", arg)
+	return t.str(fmt.Sprintf("???(%v)", arg))
 }
 
-// revCondMap maps a conditional register bit to its inverse, if possible.
-var revCondMap = map[string]string{
-	"LT": "GE", "GT": "LE", "EQ": "NE",
+// plan9Reg returns the name of r, which is g for R30 under ModeGAlias.
+// No other register has an alias: the stack pointer R1 and the TOC
+// pointer R2 print as R1 and R2 in every mode, so that stack and TOC
+// references such as -16(R1) read the same in Go and non-Go code.
+func plan9Reg(r Reg, mode Mode) string {
+	if r == R30 && mode&ModeGAlias != 0 {
+		return "g"
+	}
+	return r.String()
+}
+
+// noSymbols is the SymLookup of a program without a symbol table.
+func noSymbols(uint64) (string, uint64) { return "", 0 }
+
+// appendPlan9Addr appends the code or data address addr to dst,
+// relative to the symbol containing it if any.
+func appendPlan9Addr(dst []byte, addr uint64, symname SymLookup) []byte {
+	if s, base := symname(addr); s != "" {
+		dst = append(dst, s...)
+		if addr != base {
+			dst = strconv.AppendUint(append(dst, '+'), addr-base, 10)
+		}
+		return append(dst, "(SB)"...)
+	}
+	return strconv.AppendUint(append(dst, "0x"...), addr, 16)
+}
+
+// isLogicalImmOp reports whether op is a logical instruction with an
+// immediate operand, whose immediate is a bit mask.
+func isLogicalImmOp(op Op) bool {
+	switch op {
+	case ANDI_, ANDIS_, ORI, ORIS, XORI, XORIS:
+		return true
+	}
+	return false
+}
+
+// isBitPatternImmOp reports whether the immediate of op is a bit pattern
+// rather than a number, which prints in hexadecimal in every mode: the mask
+// of a logical immediate instruction, the word that xxspltiw splats, and
+// the single-precision value that xxspltidp converts to double precision.
+func isBitPatternImmOp(op Op) bool {
+	return isLogicalImmOp(op) || op == XXSPLTIW || op == XXSPLTIDP
+}
+
+// isCRBitOp reports whether op operates on arbitrary CR bits, like the
+// CR logical instructions, isel and setbc. Its CR bit operands are always
+// printed as 4*CRn+bit, even in CR0.
+func isCRBitOp(op Op) bool {
+	switch op {
+	case CRAND, CRANDC, CREQV, CRNAND, CRNOR, CROR, CRORC, CRXOR:
+		return true
+	case ISEL, SETBC, SETBCR, SETNBC, SETNBCR:
+		return true
+	}
+	return false
+}
+
+// plan9Indexed formats the indexed memory operand (RA)(RB) of an X-form
+// instruction whose RA and RB are the arguments i and i+1 into t.
+// An RA of R0 means no base register.
+func plan9Indexed(t *plan9Text, inst Inst, args []span, i int) span {
+	if inst.Args[i] == R0 {
+		return t.wrap("(", args[i+1], ")")
+	}
+	lo := len(t.buf)
+	t.wrap("(", args[i], ")")
+	t.wrap("(", args[i+1], ")")
+	return t.from(lo)
+}
+
+// plan9Rotate returns the shift or mask form of the rotate instruction inst,
+// or ok == false if its shift and mask have no special meaning.
+func plan9Rotate(t *plan9Text, inst Inst, args []span) (op span, operands []span, ok bool) {
+	rs, ra := args[1], args[0]
+	sh := int(inst.Args[2].(Imm))
+	mb := int(inst.Args[3].(Imm))
+	form := func(op string, n int) (span, []span, bool) {
+		return t.str(op), append(args[:0], t.imm(int64(n)), rs, ra), true
+	}
+	switch inst.Op {
+	case RLWINM:
+		me := int(inst.Args[4].(Imm))
+		switch {
+		case mb == 0 && me == 31: // rotlwi
+			return form("ROTLW", sh)
+		case mb == 0 && me == 31-sh: // slwi
+			return form("SLW", sh)
+		case sh != 0 && mb == 32-sh && me == 31: // srwi
+			return form("SRW", mb)
+		case sh == 0 && me == 31: // clrlwi
+			return form("CLRLWI", mb)
+		case me == 31-sh && mb+sh <= 31: // clrlslwi
+			return t.str("CLRLSLWI"), append(args[:0], t.imm(int64(mb+sh)), rs, t.imm(int64(sh)), ra), true
+		}
+	case RLDICL:
+		switch {
+		case mb == 0: // rotldi
+			return form("ROTL", sh)
+		case sh == 0: // clrldi
+			return form("CLRLDI", mb)
+		case mb == 64-sh: // srdi
+			return form("SRD", mb)
+		}
+	case RLDICR:
+		me := mb
+		switch {
+		case me == 63-sh: // sldi
+			return form("SLD", sh)
+		case sh == 0: // clrrdi
+			return form("CLRRDI", 63-me)
+		}
+	case RLDIC:
+		if mb+sh <= 63 { // clrlsldi
+			return t.str("CLRLSLDI"), append(args[:0], t.imm(int64(mb+sh)), rs, t.imm(int64(sh)), ra), true
+		}
+	}
+	return span{}, nil, false
+}
+
+// plan9CondBranch returns the Go extended mnemonic form of the conditional
+// branch inst, whose target is args[2], or ok == false if its BO field has
+// no extended form.
+func plan9CondBranch(t *plan9Text, inst Inst, args []span) (op span, operands []span, ok bool) {
+	bo, hint, ok := branchHint(int(inst.Args[0].(Imm)))
+	if !ok {
+		return span{}, nil, false
+	}
+	target := args[2]
+	bi := inst.Args[1].(CondReg)
+	var name string
+	switch bo {
+	case 12: // branch if CR bit set
+		name = [4]string{"BLT", "BGT", "BEQ", "BVS"}[bi.Bit()]
+	case 4: // branch if CR bit clear
+		name = [4]string{"BGE", "BLE", "BNE", "BVC"}[bi.Bit()]
+	case 16: // decrement CTR, branch if CTR != 0
+		name = "BDNZ"
+	case 18: // decrement CTR, branch if CTR == 0
+		name = "BDZ"
+	default:
+		return span{}, nil, false
+	}
+	lo := len(t.buf)
+	t.buf = append(append(t.buf, name...), hint...)
+	op = t.from(lo)
+	if bo != 16 && bo != 18 && bi.Field() != 0 {
+		return op, append(args[:0], t.str(crFieldNames[bi.Field()]), target), true
+	}
+	return op, append(args[:0], target), true
+}
+
+// plan9OpName returns the Go assembler mnemonic for op.
+// Ops not in plan9OpMap use the upper-case Power ISA mnemonic, with the
+// record form suffix "." (Rc=1) spelled CC and the overflow form suffix
+// "o" (OE=1) spelled V, so add, add., addo and addo. are
+// ADD, ADDCC, ADDV and ADDVCC.
+func plan9OpName(op Op) string {
+	plan9OpNamesOnce.Do(func() {
+		plan9OpNames = make([]string, len(opstr))
+		for op := range plan9OpNames {
+			plan9OpNames[op] = plan9OpNameOf(Op(op))
+		}
+	})
+	if int(op) < len(plan9OpNames) {
+		return plan9OpNames[op]
+	}
+	return plan9OpNameOf(op)
+}
+
+// plan9OpNames caches plan9OpName for each Op.
+var (
+	plan9OpNamesOnce sync.Once
+	plan9OpNames     []string
+)
+
+// plan9OpNameOf computes plan9OpName(op).
+func plan9OpNameOf(op Op) string {
+	if s := plan9OpMap[op]; s != "" {
+		return s
+	}
+	s := strings.ToUpper(op.String())
+	cc := ""
+	if strings.HasSuffix(s, ".") {
+		s, cc = s[:len(s)-1], "CC"
+	}
+	if base := strings.TrimSuffix(s, "O"); base != s && plan9OverflowOps[base] {
+		s = base + "V"
+	}
+	// The Go assembler spells subf as SUB, with the same operands:
+	// subf r3,r4,r5 computes r3 = r5 - r4, and so does SUB R4, R5, R3.
+	if strings.HasPrefix(s, "SUBF") && plan9OverflowOps[strings.TrimSuffix(s, "V")] {
+		s = "SUB" + s[len("SUBF"):]
+	}
+	return s + cc
+}
+
+// plan9OverflowOps is the set of XO-form instructions with an overflow form,
+// by upper-case mnemonic.
+var plan9OverflowOps = map[string]bool{
+	"ADD": true, "ADDC": true, "ADDE": true, "ADDME": true, "ADDZE": true,
+	"SUBF": true, "SUBFC": true, "SUBFE": true, "SUBFME": true, "SUBFZE": true,
+	"NEG":   true,
+	"MULLW": true, "MULLD": true,
+	"DIVW": true, "DIVWU": true, "DIVWE": true, "DIVWEU": true,
+	"DIVD": true, "DIVDU": true, "DIVDE": true, "DIVDEU": true,
 }
 
 // plan9OpMap maps an Op to its Plan 9 mnemonics, if different than its GNU mnemonics.
+// Record and overflow forms that follow the CC and V convention of plan9OpName
+// need no entry.
 var plan9OpMap = map[Op]string{
 	LBZ: "MOVBZ", STB: "MOVB",
-	LBZU: "MOVBZU", STBU: "MOVBU", // TODO(minux): indexed forms are not handled
+	LBZU: "MOVBZU", STBU: "MOVBU",
+	LBZX: "MOVBZ", STBX: "MOVB",
+	LBZUX: "MOVBZU", STBUX: "MOVBU",
 	LHZ: "MOVHZ", LHA: "MOVH", STH: "MOVH",
-	LHZU: "MOVHZU", STHU: "MOVHU",
+	LHZU: "MOVHZU", LHAU: "MOVHU", STHU: "MOVHU",
+	LHZX: "MOVHZ", LHAX: "MOVH", STHX: "MOVH",
+	LHZUX: "MOVHZU", LHAUX: "MOVHU", STHUX: "MOVHU",
 	LWZ: "MOVWZ", LWA: "MOVW", STW: "MOVW",
 	LWZU: "MOVWZU", STWU: "MOVWU",
+	LWZX: "MOVWZ", LWAX: "MOVW", STWX: "MOVW",
+	LWZUX: "MOVWZU", LWAUX: "MOVWU", STWUX: "MOVWU",
 	LD: "MOVD", STD: "MOVD",
 	LDU: "MOVDU", STDU: "MOVDU",
-	MTSPR: "MOV", MFSPR: "MOV", // the width is ambiguous for SPRs
+	LDX: "MOVD", STDX: "MOVD",
+	LDUX: "MOVDU", STDUX: "MOVDU",
+	LMW: "MOVMW", STMW: "MOVMW",
+	LSWI: "LSW", LSWX: "LSW", STSWI: "STSW", STSWX: "STSW",
+	LFS: "FMOVS", LFSU: "FMOVSU", LFSX: "FMOVS", LFSUX: "FMOVSU",
+	LFD: "FMOVD", LFDU: "FMOVDU", LFDX: "FMOVD", LFDUX: "FMOVDU",
+	STFS: "FMOVS", STFSU: "FMOVSU", STFSX: "FMOVS", STFSUX: "FMOVSU",
+	STFD: "FMOVD", STFDU: "FMOVDU", STFDX: "FMOVD", STFDUX: "FMOVDU",
+	FMR: "FMOVD", FMR_: "FMOVDCC",
+	EXTSB: "MOVB", EXTSH: "MOVH", EXTSW: "MOVW",
+	LHBRX: "MOVHBR", STHBRX: "MOVHBR",
+	LWBRX: "MOVWBR", STWBRX: "MOVWBR",
+	LDBRX: "MOVDBR", STDBRX: "MOVDBR",
+	LBARX: "LBAR", LHARX: "LHAR", LWARX: "LWAR", LDARX: "LDAR", LQARX: "LQAR",
+	STBCX_: "STBCCC", STHCX_: "STHCCC", STWCX_: "STWCCC", STDCX_: "STDCCC", STQCX_: "STQCCC",
+	MTSPR: "MOVD", MFSPR: "MOVD",
+	LI: "MOVD", LIS: "MOVD", ADDI: "ADD",
 	B:     "BR",
 	CMPLD: "CMPU", CMPLW: "CMPWU",
 	CMPD: "CMP", CMPW: "CMPW",
+	CMPLDI: "CMPU", CMPLWI: "CMPWU",
+	CMPDI: "CMP", CMPWI: "CMPW",
+}
+
+// plan9SpRegNames maps special purpose register numbers to their names.
+// SPRs not listed here are printed as SPR(n).
+var plan9SpRegNames = map[SpReg]string{
+	1:   "XER",
+	3:   "DSCR", // user-level alias of SPR 17
+	8:   "LR",
+	9:   "CTR",
+	13:  "AMR",
+	17:  "DSCR",
+	18:  "DSISR",
+	19:  "DAR",
+	22:  "DEC",
+	26:  "SRR0",
+	27:  "SRR1",
+	28:  "CFAR",
+	256: "VRSAVE",
+	268: "TB",
+	269: "TBU",
+	272: "SPRG0",
+	273: "SPRG1",
+	274: "SPRG2",
+	275: "SPRG3",
+	287: "PVR",
+	// the performance monitor: problem state accesses it at the numbers
+	// below 784, which have a U prefix as in Linux, and privileged code at
+	// the numbers from 784
+	768: "USIER",
+	769: "UMMCR2",
+	770: "UMMCRA",
+	771: "UPMC1",
+	772: "UPMC2",
+	773: "UPMC3",
+	774: "UPMC4",
+	775: "UPMC5",
+	776: "UPMC6",
+	779: "UMMCR0",
+	780: "USIAR",
+	781: "USDAR",
+	782: "UMMCR1",
+	784: "SIER",
+	785: "MMCR2",
+	786: "MMCRA",
+	787: "PMC1",
+	788: "PMC2",
+	789: "PMC3",
+	790: "PMC4",
+	791: "PMC5",
+	792: "PMC6",
+	795: "MMCR0",
+	796: "SIAR",
+	797: "SDAR",
+	798: "MMCR1",
+	// event-based branches
+	800: "BESCRS",
+	801: "BESCRSU",
+	802: "BESCRR",
+	803: "BESCRRU",
+	804: "EBBHR",
+	805: "EBBRR",
+	806: "BESCR",
+	815: "TAR",
+}
+
+// isFloatCompareOp reports whether op is a binary or decimal floating-point
+// compare, whose BF operand defaults to CR0 in the Go assembler.
+func isFloatCompareOp(op Op) bool {
+	switch op {
+	case FCMPU, FCMPO, DCMPU, DCMPO, DCMPUQ, DCMPOQ:
+		return true
+	}
+	return false
 }
@@ -132,25 +132,34 @@ func (f Field) String() string {
 
 type Inst struct {
 	Text     string
+	Tags     []string
 	Encoding string
 	Op       string
-	Mask     uint32
-	Value    uint32
-	DontCare uint32
+	Mask     uint64
+	Value    uint64
+	DontCare uint64
 	Fields   []Field
 }
 
 func (i Inst) String() string {
-	return fmt.Sprintf("%s (%s) %08x/%08x[%08x] %v (%s)", i.Op, i.Encoding, i.Value, i.Mask, i.DontCare, i.Fields, i.Text)
+	return fmt.Sprintf("%s (%s) %016x/%016x[%016x] %v (%s)", i.Op, i.Encoding, i.Value, i.Mask, i.DontCare, i.Fields, i.Text)
 }
 
+// An Arg is a field in the instruction encoding. Word is 0 for fields in the
+// first (or only) instruction word, and 1 for fields in the suffix word of
+// a prefixed instruction. The first word occupies the upper 32 bits of
+// Mask, Value and DontCare.
 type Arg struct {
 	Name string
 	Bits int8
 	Offs int8
+	Word int8
 }
 
 func (a Arg) String() string {
+	if a.Word > 0 {
+		return fmt.Sprintf("%s[%d:%d]@%d", a.Name, a.Offs, a.Offs+a.Bits-1, a.Word)
+	}
 	return fmt.Sprintf("%s[%d:%d]", a.Name, a.Offs, a.Offs+a.Bits-1)
 }
 
@@ -158,12 +167,12 @@ func (a Arg) Maximum() int {
 	return 1<<uint8(a.Bits) - 1
 }
 
-func (a Arg) BitMask() uint32 {
-	return uint32(a.Maximum()) << a.Shift()
+func (a Arg) BitMask() uint64 {
+	return uint64(a.Maximum()) << a.Shift()
 }
 
 func (a Arg) Shift() uint8 {
-	return uint8(32 - a.Offs - a.Bits)
+	return uint8(32-a.Offs-a.Bits) + 32*uint8(1-a.Word)
 }
 
 type Args []Arg
@@ -213,10 +222,24 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 	// The first field in the encoding is the smallest offset.
 	// And note the MSB is bit 0, not bit 31.
 	// Example: "31@0|RS@6|RA@11|///@16|26@21|Rc@31|"
+	// A prefixed instruction lists the fields of the suffix word after
+	// those of the prefix word, starting with a comma.
+	// Example: "1@0|2@6|0@8|//@9|R@11|//@12|si0@14|,14@0|RT@6|RA@11|si1@16|"
 	var args Args
+	var word int8
 	fields := strings.Split(encoding, "|")
 	for i, f := range fields {
 		name, off := "", -1
+		if strings.HasPrefix(f, ",") {
+			if word > 0 || len(args) == 0 {
+				fmt.Fprintf(os.Stderr, "%s: wrong %d-th encoding field: %q\n", text, i, f)
+				return
+			}
+			// the last field of the prefix word ends at bit 32.
+			args[len(args)-1].Bits += 32
+			word++
+			f = f[1:]
+		}
 		if f == "" {
 			off = 32
 			if i == 0 || i != len(fields)-1 {
@@ -232,16 +255,16 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 			off, _ = strconv.Atoi(f[j+1:])
 			name = f[:j]
 		}
-		if len(args) > 0 {
+		if len(args) > 0 && args[len(args)-1].Word == word {
 			args[len(args)-1].Bits += int8(off)
 		}
 		if name != "" {
-			arg := Arg{Name: name, Offs: int8(off), Bits: int8(-off)}
+			arg := Arg{Name: name, Offs: int8(off), Bits: int8(-off), Word: word}
 			args.Append(arg)
 		}
 	}
 
-	var mask, value, dontCare uint32
+	var mask, value, dontCare uint64
 	for i := 0; i < len(args); i++ {
 		arg := args[i]
 		v, err := strconv.Atoi(arg.Name)
@@ -251,7 +274,7 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 				fmt.Fprintf(os.Stderr, "%s: field %s value (%d) is out of range (%d-bit)\n", text, arg, v, arg.Bits)
 			}
 			mask |= arg.BitMask()
-			value |= uint32(v) << arg.Shift()
+			value |= uint64(v) << arg.Shift()
 			args.Delete(i)
 			i--
 		case arg.Name[0] == '/': // is don't care
@@ -284,7 +307,7 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 	if value&^mask != 0 {
 		log.Fatalf("%s: value (%08x) out of range of mask (%08x)", text, value, mask)
 	}
-	var argMask uint32
+	var argMask uint64
 	for _, arg := range args {
 		if arg.Bits <= 0 || arg.Bits > 32 || arg.Offs > 31 || arg.Offs <= 0 {
 			log.Fatalf("%s: arg %v has wrong bit field spec", text, arg)
@@ -297,8 +320,8 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 		}
 		argMask |= arg.BitMask()
 	}
-	if 1<<32-1 != mask|dontCare|argMask {
-		log.Fatalf("%s: args %v fail to cover all 32 bits", text, args)
+	if allBits := ^uint64(0) << (32 - 32*uint(word)); allBits != mask|dontCare|argMask {
+		log.Fatalf("%s: args %v fail to cover all %d bits", text, args, 32*(word+1))
 	}
 
 	// split mnemonics into individual instructions
@@ -327,10 +350,10 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 				isPCRel = false
 			}
 			mask |= args[i].BitMask()
-			value |= uint32(v) << args[i].Shift()
+			value |= uint64(v) << args[i].Shift()
 			args.Delete(i)
 		}
-		inst := Inst{Text: text, Encoding: parts[1], Value: value, Mask: mask, DontCare: dontCare}
+		inst := Inst{Text: text, Tags: strings.Fields(tags), Encoding: parts[1], Value: value, Mask: mask, DontCare: dontCare}
 
 		// order inst.Args according to mnemonics order
 		for i, opr := range operandRe.FindAllString(parts[1], -1) {
@@ -341,7 +364,8 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 			field := Field{Name: opr}
 			typ := asm.TypeUnknown
 			var shift uint8
-			opr2 := ""
+			opr2, opr3 := "", ""
+			leadingOne := false
 			switch opr {
 			case "target_addr":
 				shift = 2
@@ -355,7 +379,7 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 				} else {
 					opr = "BD"
 				}
-			case "UI", "BO", "BH", "TH", "LEV", "NB", "L", "TO", "FXM", "U", "W", "FLM", "UIM", "SHB", "SHW", "ST", "SIX", "PS", "DCM", "DGM", "RMC", "R", "SP", "S", "DM", "CT", "EH", "E", "MO", "WC", "A", "IH", "OC", "DUI", "DUIS":
+			case "UI", "BO", "BH", "TH", "LEV", "NB", "L", "TO", "FXM", "U", "W", "FLM", "UIM", "SHB", "SHW", "ST", "SIX", "PS", "DCM", "DGM", "RMC", "R", "SP", "S", "DM", "CT", "EH", "E", "MO", "WC", "A", "IH", "OC", "DUI", "DUIS", "SC", "RIC", "PRS", "PL", "DRM", "RM", "CY", "XMSK", "YMSK", "PMSK":
 				typ = asm.TypeImmUnsigned
 				if i := args.Find(opr); i < 0 {
 					opr = "D"
@@ -371,11 +395,26 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 				if n := strings.ToLower(opr); args.Find(n) >= 0 {
 					opr = n // xx[5] || xx[0:4]
 				}
+			case "IMM8":
+				typ = asm.TypeImmSigned
+			case "IMM32": // imm0 || imm1, split across prefix and suffix
+				typ = asm.TypeImmUnsigned
+				opr = "imm0"
+				opr2 = "imm1"
 			case "SI", "SIM", "TE":
 				typ = asm.TypeImmSigned
-				if i := args.Find(opr); i < 0 {
+				if args.Find("si0") >= 0 { // si0 || si1, split across prefix and suffix
+					opr = "si0"
+					opr2 = "si1"
+				} else if i := args.Find(opr); i < 0 {
 					opr = "D"
 				}
+			case "offset": // EXTS(0b1 || DX || D || 0b000) of hashst and hashchk
+				typ = asm.TypeOffset
+				shift = 3
+				opr = "DX"
+				opr2 = "D"
+				leadingOne = true
 			case "DS":
 				typ = asm.TypeOffset
 				shift = 2
@@ -383,10 +422,30 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 				typ = asm.TypeOffset
 				shift = 4
 			case "D":
+				if args.Find("d2") >= 0 { // d0 || d1 || d2 of addpcis
+					typ = asm.TypeImmSigned
+					opr = "d0"
+					opr2 = "d1"
+					opr3 = "d2"
+					break
+				}
+				if args.Find("d0") >= 0 { // d0 || d1, split across prefix and suffix
+					typ = asm.TypeOffset
+					opr = "d0"
+					opr2 = "d1"
+					break
+				}
 				if i := args.Find(opr); i >= 0 {
 					typ = asm.TypeOffset
 					break
 				}
+				if i := args.Find("UI"); i >= 0 && strings.HasPrefix(inst.Op, "ev") {
+					// the SPE loads and stores scale UI by the access size
+					typ = asm.TypeOffsetUnsigned
+					opr = "UI"
+					shift = speShift(inst.Op)
+					break
+				}
 				if i := args.Find("UI"); i >= 0 {
 					typ = asm.TypeImmUnsigned
 					opr = "UI"
@@ -397,18 +456,32 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 					opr = "SI"
 					break
 				}
-			case "RA", "RB", "RS", "RSp", "RT", "RTp":
+			case "RA", "RB", "RC", "RS", "RSp", "RT", "RTp":
 				typ = asm.TypeReg
 			case "BT", "BA", "BB", "BC", "BI":
 				typ = asm.TypeCondRegBit
+				if strings.HasPrefix(inst.Op, "mtfsb") {
+					typ = asm.TypeImmUnsigned // an FPSCR bit
+				}
 			case "BF", "BFA":
 				typ = asm.TypeCondRegField
-			case "FRA", "FRB", "FRBp", "FRC", "FRS", "FRSp", "FRT", "FRTp":
+			case "FRA", "FRAp", "FRB", "FRBp", "FRC", "FRS", "FRSp", "FRT", "FRTp":
 				typ = asm.TypeFPReg
 			case "XA", "XB", "XC", "XS", "XT": // 5-bit, split field
-				typ = asm.TypeVecReg
+				typ = asm.TypeVecSReg
 				opr2 = opr[1:]
 				opr = opr[1:] + "X"
+			case "XAp": // 32*AX + A, an even register
+				typ = asm.TypeVecSReg
+				opr = "AX"
+				opr2 = "A"
+			case "AS", "AT":
+				typ = asm.TypeACCReg
+			case "XSp", "XTp": // 32*SX + 2*Sp, an even register
+				typ = asm.TypeVecSReg
+				opr2 = opr[1:]
+				opr = opr[1:2] + "X"
+				shift = 1
 			case "VRA", "VRB", "VRC", "VRS", "VRT":
 				typ = asm.TypeVecReg
 			case "SPR", "DCRN", "BHRBE", "TBR", "SR", "TMR", "PMRN": // Note: if you add to this list and the register field needs special handling, add it to switch statement below
@@ -428,19 +501,26 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 			}
 			field.Type = typ
 			field.Shift = shift
-			var f1, f2 asm.BitField
+			var f1, f2, f3 asm.BitField
 			switch {
 			case opr2 != "":
 				ext := args.Find(opr)
 				if ext < 0 {
 					log.Fatalf("%s: couldn't find extended field %s in %s", text, opr, args)
 				}
-				f1.Offs, f1.Bits = uint8(args[ext].Offs), uint8(args[ext].Bits)
+				f1.Offs, f1.Bits, f1.Word = uint8(args[ext].Offs), uint8(args[ext].Bits), uint8(args[ext].Word)
 				base := args.Find(opr2)
 				if base < 0 {
 					log.Fatalf("%s: couldn't find base field %s in %s", text, opr2, args)
 				}
-				f2.Offs, f2.Bits = uint8(args[base].Offs), uint8(args[base].Bits)
+				f2.Offs, f2.Bits, f2.Word = uint8(args[base].Offs), uint8(args[base].Bits), uint8(args[base].Word)
+				if opr3 != "" {
+					low := args.Find(opr3)
+					if low < 0 {
+						log.Fatalf("%s: couldn't find low field %s in %s", text, opr3, args)
+					}
+					f3.Offs, f3.Bits, f3.Word = uint8(args[low].Offs), uint8(args[low].Bits), uint8(args[low].Word)
+				}
 			case opr == "mb", opr == "me": // xx[5] || xx[0:4]
 				i := args.Find(opr)
 				if i < 0 {
@@ -463,12 +543,23 @@ func add(p *Prog, text, mnemonics, encoding, tags string) {
 				if i < 0 {
 					log.Fatalf("%s: couldn't find %s in %s", text, opr, args)
 				}
-				f1.Offs, f1.Bits = uint8(args[i].Offs), uint8(args[i].Bits)
+				f1.Offs, f1.Bits, f1.Word = uint8(args[i].Offs), uint8(args[i].Bits), uint8(args[i].Word)
+			}
+			if leadingOne {
+				// the constant 1 is the last bit of primary opcode 31,
+				// which Decode has already matched
+				if !strings.HasPrefix(encoding, "31@0|") {
+					log.Fatalf("%s: %s needs primary opcode 31", text, opr)
+				}
+				field.BitFields.Append(asm.BitField{Offs: 5, Bits: 1})
 			}
 			field.BitFields.Append(f1)
 			if f2.Bits > 0 {
 				field.BitFields.Append(f2)
 			}
+			if f3.Bits > 0 {
+				field.BitFields.Append(f3)
+			}
 			inst.Fields = append(inst.Fields, field)
 		}
 		if *debug {
@@ -508,7 +599,9 @@ func opName(op string) string {
 func argFieldName(f Field) string {
 	ns := []string{"ap", f.Type.String()}
 	for _, b := range f.BitFields {
-		ns = append(ns, fmt.Sprintf("%d_%d", b.Offs, b.Offs+b.Bits-1))
+		// fields of a suffix word are numbered from bit 32 of the prefix word.
+		offs := int(b.Offs) + 32*int(b.Word)
+		ns = append(ns, fmt.Sprintf("%d_%d", offs, offs+int(b.Bits)-1))
 	}
 	if f.Shift > 0 {
 		ns = append(ns, fmt.Sprintf("shift%d", f.Shift))
@@ -518,6 +611,173 @@ func argFieldName(f Field) string {
 
 var funcBodyTmpl = template.Must(template.New("funcBody").Parse(``))
 
+// loadRe and storeRe match the headlines of load and store instructions.
+// The Load Vector for Shift instructions only compute a permute control vector.
+// The hash instructions store a hash of the LR and check it against the stored one.
+var (
+	loadRe  = regexp.MustCompile(`^((Prefixed |Vector )?Load |Hash Check )`)
+	storeRe = regexp.MustCompile(`^(Prefixed |Vector |Hash )?Store `)
+)
+
+// opFlags returns the opFlag expression for the properties of inst,
+// or "" if it has none.
+func opFlags(inst Inst) string {
+	var flags []string
+	text := inst.Text
+	if strings.HasPrefix(text, "Branch ") {
+		flags = append(flags, "flagBranch")
+	}
+	if loadRe.MatchString(text) && !strings.HasPrefix(text, "Load Vector for Shift") {
+		flags = append(flags, "flagLoad")
+	}
+	if storeRe.MatchString(text) {
+		flags = append(flags, "flagStore")
+	}
+	for _, tag := range inst.Tags {
+		if tag == "privileged" {
+			flags = append(flags, "flagPrivileged")
+		}
+	}
+	if dstFirst(inst) {
+		flags = append(flags, "flagDst")
+	}
+	if dstRead(inst) {
+		flags = append(flags, "flagDstRead")
+	}
+	if strings.Contains(text, " with Update") {
+		flags = append(flags, "flagUpdate")
+	}
+	// SPE instructions are EVX-form, except for evsel
+	if strings.HasSuffix(text, " EVX-form") || strings.HasSuffix(text, " EVS-form") {
+		flags = append(flags, "flagSPE")
+	}
+	// the Floating-Point and Decimal Floating-Point facilities, and SPE
+	// scalar floating-point, but not vector floating-point instructions,
+	// masked MMA ones included
+	vec := strings.TrimPrefix(text, "Prefixed Masked ")
+	fp := !strings.HasPrefix(vec, "Vector ") && !strings.HasPrefix(vec, "VSX ") &&
+		(strings.Contains(text, "Floating") || strings.Contains(text, "FPSCR") || strings.HasPrefix(text, "DFP "))
+	if fp {
+		flags = append(flags, "flagFloatingPoint")
+	}
+	// the record forms (Rc=1) set CR0, except that the floating-point
+	// ones set CR1, and the vector compares and the decimal (BCD)
+	// arithmetic CR6
+	if strings.HasSuffix(inst.Op, ".") {
+		switch {
+		case fp:
+			flags = append(flags, "flagRecordCR1")
+		case strings.HasPrefix(text, "Vector "), strings.HasPrefix(text, "VSX Vector "), strings.HasPrefix(text, "Decimal "):
+			flags = append(flags, "flagRecordCR6")
+		}
+	}
+	return strings.Join(flags, " | ")
+}
+
+// dstFirst reports whether the first operand of inst is a register it
+// writes, like the RT of add, the BF of cmp, the SPR of mtspr and the
+// FXM of mtcrf, which selects the CR fields it writes.
+// A first RA is only a destination if RS or XS is the source, as in and
+// and mfvsrld: the RA of dcbz and copy is an address.
+func dstFirst(inst Inst) bool {
+	if len(inst.Fields) == 0 {
+		return false
+	}
+	switch inst.Fields[0].Name {
+	case "RT", "RTp", "FRT", "FRTp", "VRT", "XT", "XTp", "AT", "BF", "BT",
+		"SPR", "SR", "DCRN", "PMRN", "TMR", "FXM":
+		return true
+	case "RA":
+		for _, f := range inst.Fields[1:] {
+			if f.Name == "RS" || f.Name == "XS" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dstRead reports whether inst also reads the register its first operand
+// writes: the rotates that insert into RA, like rlwimi, the vector inserts
+// that replace one element of VRT, like vinsertw, the VSX multiply-adds
+// that accumulate into XT, like xsmaddadp, and the MMA rank-k updates that
+// accumulate into AT, like xvf32gerpp.
+func dstRead(inst Inst) bool {
+	text := inst.Text
+	return strings.Contains(text, "Mask Insert ") || strings.HasPrefix(text, "Vector Insert ") ||
+		strings.HasSuffix(text, " XX3-form") && (strings.Contains(text, "Multiply-Add") || strings.Contains(text, "Multiply-Subtract")) ||
+		strings.Contains(text, " accumulate")
+}
+
+// speShift returns the shift of the UI displacement of the SPE load or
+// store op, like evldd, evlwhe and evlhhesplat, from the size of the
+// doubleword, word or halfword it accesses, which follows evl or evst.
+func speShift(op string) uint8 {
+	size := strings.TrimPrefix(strings.TrimPrefix(op, "evl"), "evst")
+	switch size[0] {
+	case 'd':
+		return 3
+	case 'w':
+		return 2
+	case 'h':
+		return 1
+	}
+	log.Fatalf("%s: unknown SPE access size", op)
+	return 0
+}
+
+// raZeroArgs returns the mask of the argument indexes of inst that are
+// (RA|0) fields, as tagged in the csv, or 0 if it has none.
+func raZeroArgs(inst Inst) uint8 {
+	tagged := false
+	for _, tag := range inst.Tags {
+		if tag == "RA|0" {
+			tagged = true
+		}
+	}
+	if !tagged {
+		return 0
+	}
+	var mask uint8
+	for i, f := range inst.Fields {
+		if f.Name == "RA" {
+			mask |= 1 << uint(i)
+		}
+	}
+	return mask
+}
+
+// regPairArgs returns the mask of the argument indexes of inst that name
+// an even-odd register pair, like the RTp of lq and the FRTp of daddq,
+// or 0 if it has none.
+func regPairArgs(inst Inst) uint8 {
+	var mask uint8
+	for i, f := range inst.Fields {
+		if strings.HasSuffix(f.Name, "p") {
+			mask |= 1 << uint(i)
+		}
+	}
+	return mask
+}
+
+// printArgMasks emits the table called name, which maps each opcode
+// to the mask of its argument indexes computed by argMask.
+func printArgMasks(buf *bytes.Buffer, p *Prog, name string, argMask func(Inst) uint8) {
+	m := map[string]bool{}
+	fmt.Fprintf(buf, "var %s = [...]uint8{\n", name)
+	for _, inst := range p.Insts {
+		op := opName(inst.Op)
+		if ok := m[op]; ok {
+			continue
+		}
+		m[op] = true
+		if mask := argMask(inst); mask != 0 {
+			fmt.Fprintf(buf, "\t%s: %#x,\n", op, mask)
+		}
+	}
+	fmt.Fprintf(buf, "}\n\n")
+}
+
 // printDecoder implements the -fmt=decoder mode.
 // It emits the tables.go for package armasm's decoder.
 func printDecoder(p *Prog) {
@@ -536,7 +796,7 @@ func printDecoder(p *Prog) {
 		m[name] = true
 		fmt.Fprintf(&buf, "\t%s\n", name)
 	}
-	fmt.Fprintln(&buf, ")\n\n")
+	fmt.Fprintf(&buf, ")\n\n")
 
 	// Emit slice mapping opcode number to name string.
 	m = map[string]bool{}
@@ -549,7 +809,26 @@ func printDecoder(p *Prog) {
 		m[name] = true
 		fmt.Fprintf(&buf, "\t%s: %q,\n", opName(inst.Op), inst.Op)
 	}
-	fmt.Fprintln(&buf, "}\n\n")
+	fmt.Fprintf(&buf, "}\n\n")
+
+	// Emit the properties of each opcode, from its headline and tags.
+	m = map[string]bool{}
+	fmt.Fprintf(&buf, "var opflags = [...]opFlag{\n")
+	for _, inst := range p.Insts {
+		name := opName(inst.Op)
+		if ok := m[name]; ok {
+			continue
+		}
+		m[name] = true
+		if flags := opFlags(inst); flags != "" {
+			fmt.Fprintf(&buf, "\t%s: %s,\n", name, flags)
+		}
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	// Emit the (RA|0) and register pair arguments of each opcode.
+	printArgMasks(&buf, p, "opRAZeroArgs", raZeroArgs)
+	printArgMasks(&buf, p, "opRegPairArgs", regPairArgs)
 
 	// print out argFields
 	fmt.Fprintf(&buf, "var (\n")
@@ -563,24 +842,24 @@ func printDecoder(p *Prog) {
 			m[name] = true
 			fmt.Fprintf(&buf, "\t%s = &argField{Type: %#v, Shift: %d, BitFields: BitFields{", name, f.Type, f.Shift)
 			for _, b := range f.BitFields {
-				fmt.Fprintf(&buf, "{%d, %d},", b.Offs, b.Bits)
+				fmt.Fprintf(&buf, "{%d, %d, %d},", b.Offs, b.Bits, b.Word)
 			}
 			fmt.Fprintf(&buf, "}}\n")
 		}
 	}
-	fmt.Fprintln(&buf, ")\n\n")
+	fmt.Fprintf(&buf, ")\n\n")
 
 	// Emit decoding table.
 	fmt.Fprintf(&buf, "var instFormats = [...]instFormat{\n")
 	for _, inst := range p.Insts {
 		fmt.Fprintf(&buf, "\t{ %s, %#x, %#x, %#x,", opName(inst.Op), inst.Mask, inst.Value, inst.DontCare)
-		fmt.Fprintf(&buf, " // %s (%s)\n\t\t[5]*argField{", inst.Text, inst.Encoding)
+		fmt.Fprintf(&buf, " // %s (%s)\n\t\t[6]*argField{", inst.Text, inst.Encoding)
 		for _, f := range inst.Fields {
 			fmt.Fprintf(&buf, "%s, ", argFieldName(f))
 		}
 		fmt.Fprintf(&buf, "}},\n")
 	}
-	fmt.Fprintln(&buf, "}\n")
+	fmt.Fprintf(&buf, "}\n")
 
 	out, err := gofmt.Source(buf.Bytes())
 	if err != nil {